@@ -0,0 +1,57 @@
+package slices
+
+import (
+	"context"
+	"time"
+)
+
+// Clock provides the current time and timers to the parallel functions, which
+// use it for rate limiting, retry backoff and timeouts. It allows tests to
+// control the passing of time rather than sleeping, see WithClock.
+type Clock interface {
+	// Now returns the current time.
+	Now() time.Time
+
+	// AfterFunc waits for the duration to elapse and then calls f in its own
+	// goroutine. It returns a function that stops the timer, which reports
+	// false if the timer already expired or was stopped.
+	AfterFunc(d time.Duration, f func()) (stop func() bool)
+}
+
+// WithClock sets the Clock used for rate limiting, retry backoff and timeouts.
+// The default Clock uses the time package.
+func WithClock(c Clock) ParallelOption {
+	return func(cfg *parallelConfig) {
+		cfg.clock = c
+	}
+}
+
+// systemClock is the Clock backed by the time package.
+type systemClock struct{}
+
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+func (systemClock) AfterFunc(d time.Duration, f func()) func() bool {
+	return time.AfterFunc(d, f).Stop
+}
+
+// sleep waits for the duration to elapse on the Clock or until ctx is
+// cancelled, in which case the error of ctx is returned.
+func sleep(ctx context.Context, clock Clock, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	elapsed := make(chan struct{})
+	stop := clock.AfterFunc(d, func() {
+		close(elapsed)
+	})
+	select {
+	case <-elapsed:
+		return nil
+	case <-ctx.Done():
+		stop()
+		return ctx.Err()
+	}
+}
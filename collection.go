@@ -0,0 +1,172 @@
+package slices
+
+// Collection wraps a slice exposing the functions of this package as methods so
+// they can be chained and read top to bottom rather than inside out.
+//
+//	names := MapCollection(NewCollection(users).
+//		Filter(isActive).
+//		Reverse(), func(u User) string { return u.Name })
+//
+// Operations that change the element type, such as MapCollection and
+// GroupByCollection, and operations that need comparable elements, such as
+// ContainsCollection and UniqueCollection, are free functions since Go doesn't
+// allow methods to have type parameters or to constrain the type parameters of
+// their type.
+//
+// A Collection is a slice, so it can be indexed, ranged over and passed to any
+// function accepting a []T. Like the functions they wrap, Reverse, Shuffle and
+// ReplaceIf modify the Collection in place, use Clone first to keep the original
+// intact.
+type Collection[T any] []T
+
+// NewCollection creates a Collection wrapping the slice. The slice isn't copied.
+func NewCollection[T any](s []T) Collection[T] {
+	return Collection[T](s)
+}
+
+// Filter returns a new Collection containing all the elements that satisfied
+// the Predicate.
+func (c Collection[T]) Filter(pred Predicate[T]) Collection[T] {
+	return Filter(c, pred)
+}
+
+// FindFirst returns the first element that satisfies the Predicate and a
+// boolean indicating if found.
+func (c Collection[T]) FindFirst(pred Predicate[T]) (T, bool) {
+	return FindFirst(c, pred)
+}
+
+// FindAll returns a Collection containing all the elements for which the
+// Predicate is satisfied.
+func (c Collection[T]) FindAll(pred Predicate[T]) Collection[T] {
+	return FindAll(c, pred)
+}
+
+// Any returns true if at least one element satisfies the Predicate.
+func (c Collection[T]) Any(pred Predicate[T]) bool {
+	_, ok := FindFirst(c, pred)
+	return ok
+}
+
+// CountBy returns the number of elements that satisfy the Predicate.
+func (c Collection[T]) CountBy(pred Predicate[T]) int {
+	return CountBy(c, pred)
+}
+
+// Reverse reverses the elements of the Collection in place and returns it.
+func (c Collection[T]) Reverse() Collection[T] {
+	Reverse(c)
+	return c
+}
+
+// Shuffle shuffles the elements of the Collection randomly in place and
+// returns it.
+func (c Collection[T]) Shuffle() Collection[T] {
+	Shuffle(c)
+	return c
+}
+
+// ReplaceIf replaces all elements that satisfy the Predicate with the new value
+// in place and returns the Collection.
+func (c Collection[T]) ReplaceIf(newVal T, pred Predicate[T]) Collection[T] {
+	ReplaceIf(c, newVal, pred)
+	return c
+}
+
+// Chunk splits the Collection into chunks with a max length of the provided
+// size. The chunks share the underlying array of the Collection.
+//
+// Providing a size less than 1 will result in a panic.
+func (c Collection[T]) Chunk(size int) []Collection[T] {
+	chunks := Chunk(c, size)
+	res := make([]Collection[T], len(chunks))
+	for i := range chunks {
+		res[i] = chunks[i]
+	}
+	return res
+}
+
+// Clone returns a copy of the Collection. If the Collection is nil then nil is
+// returned.
+func (c Collection[T]) Clone() Collection[T] {
+	return Clone(c)
+}
+
+// Concat returns a new Collection containing the elements of the Collection
+// followed by the elements of the others in order.
+func (c Collection[T]) Concat(others ...Collection[T]) Collection[T] {
+	return Concat(append([]Collection[T]{c}, others...)...)
+}
+
+// Reduce reduces the Collection to a value of the same type that is accumulated
+// by iterating over each element. Use ReduceCollection to reduce to a value of
+// a different type.
+func (c Collection[T]) Reduce(accum Accumulator[T, T], val T) T {
+	return Reduce(c, accum, val)
+}
+
+// ForEach passes each element of the Collection to fn.
+func (c Collection[T]) ForEach(fn func(T)) {
+	for i := 0; i < len(c); i++ {
+		fn(c[i])
+	}
+}
+
+// Stream returns a lazy Stream over the elements of the Collection.
+func (c Collection[T]) Stream() Stream[T] {
+	return NewStream(c)
+}
+
+// ContainsCollection returns true if the Collection contains at least one
+// occurrence of the specified element.
+func ContainsCollection[T comparable](c Collection[T], item T) bool {
+	return Contains(c, item)
+}
+
+// IndexCollection returns the index of the first occurrence of item found in
+// the Collection. If the item wasn't found -1 is returned.
+func IndexCollection[T comparable](c Collection[T], item T) int {
+	return Index(c, item)
+}
+
+// UniqueCollection returns a new Collection that doesn't contain any duplicate
+// elements, only the first occurrence of each element is kept.
+func UniqueCollection[T comparable](c Collection[T]) Collection[T] {
+	return Unique(c)
+}
+
+// MapCollection returns a new Collection of the values that result from
+// applying the map function to each element of the Collection.
+func MapCollection[T, R any](c Collection[T], mapper func(item T) R) Collection[R] {
+	return Map(c, mapper)
+}
+
+// FlatMapCollection returns a new Collection of the values in the slices that
+// result from applying the map function to each element of the Collection.
+func FlatMapCollection[T, R any](c Collection[T], mapper func(item T) []R) Collection[R] {
+	return FlatMap(c, mapper)
+}
+
+// ReduceCollection reduces the Collection to a value that is accumulated by
+// iterating over each element.
+func ReduceCollection[T, R any](c Collection[T], accum Accumulator[T, R], val R) R {
+	return Reduce(c, accum, val)
+}
+
+// GroupByCollection groups the elements of the Collection by the key generated
+// from the grouper function.
+func GroupByCollection[T any, K comparable](c Collection[T], grouper func(item T) K) map[K]Collection[T] {
+	result := make(map[K]Collection[T])
+	for _, item := range c {
+		key := grouper(item)
+		result[key] = append(result[key], item)
+	}
+	return result
+}
+
+// AssociateCollection converts the Collection into a map by running each
+// element through a transformer which returns a key and value. If any elements
+// generate the same key the last value will overwrite the current value.
+func AssociateCollection[T any, K comparable, V any](c Collection[T], transformer func(item T) (K, V)) map[K]V {
+	return Associate(c, transformer)
+}
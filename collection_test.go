@@ -0,0 +1,128 @@
+package slices
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCollection(t *testing.T) {
+	tests := []struct {
+		name     string
+		actual   func() Collection[int]
+		expected Collection[int]
+	}{
+		{
+			name: "Filter",
+			actual: func() Collection[int] {
+				return NewCollection(generateDataSet(10)).Filter(isEven)
+			},
+			expected: Collection[int]{0, 2, 4, 6, 8},
+		},
+		{
+			name: "Unique",
+			actual: func() Collection[int] {
+				return UniqueCollection(NewCollection([]int{3, 1, 3, 2, 1}))
+			},
+			expected: Collection[int]{3, 1, 2},
+		},
+		{
+			name: "Reverse",
+			actual: func() Collection[int] {
+				return NewCollection([]int{1, 2, 3}).Reverse()
+			},
+			expected: Collection[int]{3, 2, 1},
+		},
+		{
+			name: "Replace If",
+			actual: func() Collection[int] {
+				return NewCollection([]int{1, 2, 3, 4}).ReplaceIf(0, isEven)
+			},
+			expected: Collection[int]{1, 0, 3, 0},
+		},
+		{
+			name: "Concat",
+			actual: func() Collection[int] {
+				return NewCollection([]int{1}).Concat(Collection[int]{2, 3}, nil, Collection[int]{4})
+			},
+			expected: Collection[int]{1, 2, 3, 4},
+		},
+		{
+			name: "Chained",
+			actual: func() Collection[int] {
+				return UniqueCollection(NewCollection([]int{5, 4, 4, 3, 2, 2, 1}).
+					Filter(func(i int) bool { return i > 1 })).
+					Reverse()
+			},
+			expected: Collection[int]{2, 3, 4, 5},
+		},
+		{
+			name: "Map",
+			actual: func() Collection[int] {
+				return MapCollection(NewCollection([]string{"1", "22", "333"}), func(s string) int {
+					return len(s)
+				})
+			},
+			expected: Collection[int]{1, 2, 3},
+		},
+		{
+			name: "Flat Map",
+			actual: func() Collection[int] {
+				return FlatMapCollection(NewCollection([]int{1, 2}), func(i int) []int {
+					return []int{i, i * 10}
+				})
+			},
+			expected: Collection[int]{1, 10, 2, 20},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, test.actual())
+		})
+	}
+}
+
+func TestCollection_Queries(t *testing.T) {
+	c := NewCollection([]int{1, 2, 3, 4, 5})
+
+	first, ok := c.FindFirst(isEven)
+	assert.True(t, ok)
+	assert.Equal(t, 2, first)
+	assert.Equal(t, Collection[int]{2, 4}, c.FindAll(isEven))
+	assert.True(t, c.Any(isEven))
+	assert.Equal(t, 2, c.CountBy(isEven))
+	assert.True(t, ContainsCollection(c, 3))
+	assert.False(t, ContainsCollection(c, 6))
+	assert.Equal(t, 4, IndexCollection(c, 5))
+	assert.Equal(t, -1, IndexCollection(c, 6))
+	assert.Equal(t, 15, c.Reduce(func(agg, i int) int { return agg + i }, 0))
+	assert.Equal(t, "12345", ReduceCollection(c, func(agg string, i int) string {
+		return agg + strconv.Itoa(i)
+	}, ""))
+	assert.Equal(t, []Collection[int]{{1, 2}, {3, 4}, {5}}, c.Chunk(2))
+	assert.Equal(t, 2, c.Stream().Filter(isEven).Count())
+
+	clone := c.Clone().Reverse()
+	assert.Equal(t, Collection[int]{5, 4, 3, 2, 1}, clone)
+	assert.Equal(t, Collection[int]{1, 2, 3, 4, 5}, c)
+}
+
+func TestCollection_GroupAndAssociate(t *testing.T) {
+	c := NewCollection([]string{"a", "bb", "cc", "ddd"})
+
+	groups := GroupByCollection(c, func(s string) int {
+		return len(s)
+	})
+	assert.Equal(t, map[int]Collection[string]{
+		1: {"a"},
+		2: {"bb", "cc"},
+		3: {"ddd"},
+	}, groups)
+
+	lengths := AssociateCollection(c, func(s string) (string, int) {
+		return s, len(s)
+	})
+	assert.Equal(t, map[string]int{"a": 1, "bb": 2, "cc": 2, "ddd": 3}, lengths)
+}
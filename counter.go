@@ -0,0 +1,158 @@
+package slices
+
+import "sort"
+
+// Counter is a multiset counting the number of occurrences of each element.
+// Unlike calling Count for each element, a Counter is built in a single pass
+// over a slice.
+//
+// The zero value is an empty Counter ready to use. A Counter isn't safe for
+// concurrent use.
+type Counter[T comparable] struct {
+	counts map[T]int
+	order  map[T]int
+	seq    int
+	total  int
+}
+
+// NewCounter creates a Counter holding the number of occurrences of each
+// element of the slice.
+func NewCounter[T comparable](in []T) *Counter[T] {
+	c := &Counter[T]{
+		counts: make(map[T]int),
+		order:  make(map[T]int),
+	}
+	for _, item := range in {
+		c.Increment(item, 1)
+	}
+	return c
+}
+
+// Increment adds n occurrences of the element to the Counter. A negative n
+// removes occurrences, once an element's count drops to zero or below it's
+// removed from the Counter.
+func (c *Counter[T]) Increment(item T, n int) {
+	if c.counts == nil {
+		c.counts = make(map[T]int)
+		c.order = make(map[T]int)
+	}
+	count, ok := c.counts[item]
+	if !ok {
+		if n <= 0 {
+			return
+		}
+		c.order[item] = c.seq
+		c.seq++
+	}
+	if count+n <= 0 {
+		c.total -= count
+		delete(c.counts, item)
+		delete(c.order, item)
+		return
+	}
+	c.total += n
+	c.counts[item] = count + n
+}
+
+// Get returns the number of occurrences of the element, which is zero if the
+// element isn't in the Counter.
+func (c *Counter[T]) Get(item T) int {
+	return c.counts[item]
+}
+
+// Len returns the number of distinct elements in the Counter.
+func (c *Counter[T]) Len() int {
+	return len(c.counts)
+}
+
+// Total returns the sum of the counts of all elements.
+func (c *Counter[T]) Total() int {
+	return c.total
+}
+
+// Add adds the counts of the other Counter to this Counter.
+func (c *Counter[T]) Add(other *Counter[T]) {
+	for _, item := range other.elements() {
+		c.Increment(item, other.counts[item])
+	}
+}
+
+// Subtract subtracts the counts of the other Counter from this Counter.
+// Elements whose count drops to zero or below are removed.
+func (c *Counter[T]) Subtract(other *Counter[T]) {
+	for _, item := range other.elements() {
+		c.Increment(item, -other.counts[item])
+	}
+}
+
+// MostCommon returns the n elements with the highest counts, from most to least
+// common, as Pairs of the element and its count. Elements with the same count
+// are ordered by when they were first counted. If n is negative or greater than
+// the number of distinct elements all of them are returned.
+func (c *Counter[T]) MostCommon(n int) []Pair[T, int] {
+	return c.ranked(n, func(a, b int) bool {
+		return a > b
+	})
+}
+
+// LeastCommon returns the n elements with the lowest counts, from least to most
+// common, as Pairs of the element and its count. Elements with the same count
+// are ordered by when they were first counted. If n is negative or greater than
+// the number of distinct elements all of them are returned.
+func (c *Counter[T]) LeastCommon(n int) []Pair[T, int] {
+	return c.ranked(n, func(a, b int) bool {
+		return a < b
+	})
+}
+
+func (c *Counter[T]) ranked(n int, before func(a, b int) bool) []Pair[T, int] {
+	items := c.elements()
+	sort.SliceStable(items, func(i, j int) bool {
+		return before(c.counts[items[i]], c.counts[items[j]])
+	})
+	if n < 0 || n > len(items) {
+		n = len(items)
+	}
+	res := make([]Pair[T, int], n)
+	for i := range res {
+		res[i] = Pair[T, int]{First: items[i], Second: c.counts[items[i]]}
+	}
+	return res
+}
+
+// elements returns the distinct elements in the order they were first counted.
+func (c *Counter[T]) elements() []T {
+	items := make([]T, 0, len(c.counts))
+	for item := range c.counts {
+		items = append(items, item)
+	}
+	sort.Slice(items, func(i, j int) bool {
+		return c.order[items[i]] < c.order[items[j]]
+	})
+	return items
+}
+
+// Frequencies returns a map of each distinct element of the slice to the number
+// of times it occurs.
+func Frequencies[T comparable](in []T) map[T]int {
+	res := make(map[T]int)
+	for _, item := range in {
+		res[item]++
+	}
+	return res
+}
+
+// Mode returns the element that occurs most often in the slice and a boolean
+// indicating if the slice wasn't empty. If several elements occur equally often
+// the one that occurs first is returned.
+func Mode[T comparable](in []T) (res T, ok bool) {
+	counts := Frequencies(in)
+	best := 0
+	for _, item := range in {
+		if counts[item] > best {
+			best = counts[item]
+			res = item
+		}
+	}
+	return res, best > 0
+}
@@ -0,0 +1,111 @@
+package slices
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCounter(t *testing.T) {
+	c := NewCounter([]string{"b", "a", "c", "a", "b", "a", "d"})
+
+	assert.Equal(t, 3, c.Get("a"))
+	assert.Equal(t, 0, c.Get("z"))
+	assert.Equal(t, 4, c.Len())
+	assert.Equal(t, 7, c.Total())
+
+	tests := []struct {
+		name     string
+		actual   []Pair[string, int]
+		expected []Pair[string, int]
+	}{
+		{
+			name:     "Most Common",
+			actual:   c.MostCommon(2),
+			expected: []Pair[string, int]{{"a", 3}, {"b", 2}},
+		},
+		{
+			name:     "Most Common All",
+			actual:   c.MostCommon(-1),
+			expected: []Pair[string, int]{{"a", 3}, {"b", 2}, {"c", 1}, {"d", 1}},
+		},
+		{
+			name:     "Least Common Ties In First Seen Order",
+			actual:   c.LeastCommon(3),
+			expected: []Pair[string, int]{{"c", 1}, {"d", 1}, {"b", 2}},
+		},
+		{
+			name:     "Least Common More Than Len",
+			actual:   c.LeastCommon(10),
+			expected: []Pair[string, int]{{"c", 1}, {"d", 1}, {"b", 2}, {"a", 3}},
+		},
+		{
+			name:     "Zero",
+			actual:   c.MostCommon(0),
+			expected: []Pair[string, int]{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, test.actual)
+		})
+	}
+}
+
+func TestCounter_AddSubtract(t *testing.T) {
+	var c Counter[int]
+	c.Add(NewCounter([]int{1, 1, 2}))
+	c.Add(NewCounter([]int{2, 3}))
+	assert.Equal(t, 5, c.Total())
+	assert.Equal(t, []Pair[int, int]{{1, 2}, {2, 2}, {3, 1}}, c.MostCommon(-1))
+
+	c.Subtract(NewCounter([]int{1, 3, 3, 4}))
+	assert.Equal(t, 3, c.Total())
+	assert.Equal(t, 1, c.Get(1))
+	assert.Equal(t, 0, c.Get(3))
+	assert.Equal(t, 0, c.Get(4))
+	assert.Equal(t, []Pair[int, int]{{2, 2}, {1, 1}}, c.MostCommon(-1))
+
+	c.Increment(5, -1)
+	assert.Equal(t, 2, c.Len())
+}
+
+func TestFrequencies(t *testing.T) {
+	assert.Equal(t, map[string]int{"a": 2, "b": 1}, Frequencies([]string{"a", "b", "a"}))
+	assert.Equal(t, map[string]int{}, Frequencies[string](nil))
+}
+
+func TestMode(t *testing.T) {
+	tests := []struct {
+		name     string
+		in       []int
+		expected int
+		ok       bool
+	}{
+		{
+			name:     "Single Mode",
+			in:       []int{1, 2, 2, 3},
+			expected: 2,
+			ok:       true,
+		},
+		{
+			name:     "Tie Keeps First Occurrence",
+			in:       []int{4, 5, 5, 4},
+			expected: 4,
+			ok:       true,
+		},
+		{
+			name: "Empty",
+			in:   nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual, ok := Mode(test.in)
+			assert.Equal(t, test.ok, ok)
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
@@ -0,0 +1,101 @@
+package slices
+
+import (
+	"fmt"
+	"runtime"
+	"sync"
+)
+
+var (
+	defaultExecutor     *Executor
+	defaultExecutorOnce sync.Once
+)
+
+// Executor is a long-lived pool of goroutines the parallel functions run their
+// workers on, avoiding the cost of starting new goroutines on every call.
+//
+// An Executor never limits the parallelism of a call. When all of its goroutines
+// are busy, for example when a parallel function is called from within another,
+// the remaining workers are started on new goroutines instead of waiting for the
+// Executor, so calls can be nested without deadlocking.
+//
+// An Executor is safe for concurrent use and should be closed once it's no
+// longer needed.
+type Executor struct {
+	size  int
+	tasks chan func()
+	once  sync.Once
+	wg    sync.WaitGroup
+}
+
+// NewExecutor creates an Executor with the given number of goroutines.
+//
+// Providing a size less than 1 will result in a panic.
+func NewExecutor(size int) *Executor {
+	if size < 1 {
+		panic(fmt.Errorf("illegal size, cannot create an executor whose size is less than 1"))
+	}
+
+	e := &Executor{
+		size:  size,
+		tasks: make(chan func()),
+	}
+	e.wg.Add(size)
+	for i := 0; i < size; i++ {
+		go e.loop()
+	}
+	return e
+}
+
+// DefaultExecutor returns the Executor used by the parallel functions unless
+// WithExecutor is used. It's sized to GOMAXPROCS at the time of its creation and
+// must not be closed.
+func DefaultExecutor() *Executor {
+	defaultExecutorOnce.Do(func() {
+		defaultExecutor = NewExecutor(runtime.GOMAXPROCS(0))
+	})
+	return defaultExecutor
+}
+
+// Size returns the number of goroutines of the Executor.
+func (e *Executor) Size() int {
+	return e.size
+}
+
+// Close stops the goroutines of the Executor once they've finished the work
+// they're currently running. Parallel functions that are still using the
+// Executor fall back to starting their own goroutines. Calling Close more than
+// once has no effect.
+func (e *Executor) Close() {
+	e.once.Do(func() {
+		// A nil task tells a goroutine to stop, the channel can't be closed as
+		// parallel functions may still be trying to hand work to the Executor.
+		for i := 0; i < e.size; i++ {
+			e.tasks <- nil
+		}
+	})
+	e.wg.Wait()
+}
+
+func (e *Executor) loop() {
+	defer e.wg.Done()
+	for task := range e.tasks {
+		if task == nil {
+			return
+		}
+		task()
+	}
+}
+
+// execute runs task on an idle goroutine of the Executor, or on a new goroutine
+// if none is idle. A nil Executor always starts a new goroutine.
+func (e *Executor) execute(task func()) {
+	if e != nil {
+		select {
+		case e.tasks <- task:
+			return
+		default:
+		}
+	}
+	go task()
+}
@@ -0,0 +1,75 @@
+package slices
+
+import (
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExecutor(t *testing.T) {
+	tests := []struct {
+		name     string
+		executor func() *Executor
+	}{
+		{
+			name: "Dedicated Executor",
+			executor: func() *Executor {
+				return NewExecutor(4)
+			},
+		},
+		{
+			name: "Closed Executor",
+			executor: func() *Executor {
+				e := NewExecutor(4)
+				e.Close()
+				return e
+			},
+		},
+		{
+			name: "No Executor",
+			executor: func() *Executor {
+				return nil
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			e := test.executor()
+			if e != nil {
+				defer e.Close()
+			}
+
+			var total int64
+			ForEachParallel(generateDataSet(1000), func(i int) {
+				atomic.AddInt64(&total, int64(i))
+			}, 8, WithExecutor(e))
+			assert.Equal(t, int64(499500), total)
+		})
+	}
+}
+
+func TestExecutor_Nested(t *testing.T) {
+	e := NewExecutor(2)
+	defer e.Close()
+
+	var total int64
+	ForEachParallel(generateDataSet(10), func(i int) {
+		ForEachParallel(generateDataSet(10), func(j int) {
+			atomic.AddInt64(&total, 1)
+		}, 2, WithExecutor(e))
+	}, 2, WithExecutor(e))
+	assert.Equal(t, int64(100), total)
+}
+
+func TestNewExecutor_InvalidSize(t *testing.T) {
+	assert.Panics(t, func() {
+		NewExecutor(0)
+	})
+}
+
+func TestDefaultExecutor(t *testing.T) {
+	assert.Same(t, DefaultExecutor(), DefaultExecutor())
+	assert.Greater(t, DefaultExecutor().Size(), 0)
+}
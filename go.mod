@@ -1,6 +1,6 @@
 module github.com/jkratz55/slices
 
-go 1.18
+go 1.23
 
 require (
 	github.com/google/go-cmp v0.5.9
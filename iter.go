@@ -0,0 +1,209 @@
+package slices
+
+import (
+	"fmt"
+	"iter"
+)
+
+// All returns an iterator over the index-element pairs of the slice in order.
+func All[T any](s []T) iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i := 0; i < len(s); i++ {
+			if !yield(i, s[i]) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over the elements of the slice in order.
+func Values[T any](s []T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := 0; i < len(s); i++ {
+			if !yield(s[i]) {
+				return
+			}
+		}
+	}
+}
+
+// Collect consumes the iterator and returns a new slice containing all its
+// elements.
+func Collect[T any](seq iter.Seq[T]) []T {
+	res := make([]T, 0)
+	for item := range seq {
+		res = append(res, item)
+	}
+	return res
+}
+
+// FilterSeq returns an iterator over the elements of seq that satisfy the
+// Predicate.
+func FilterSeq[T any](seq iter.Seq[T], fn Predicate[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for item := range seq {
+			if fn(item) && !yield(item) {
+				return
+			}
+		}
+	}
+}
+
+// MapSeq returns an iterator over the values that result from applying the map
+// function to the elements of seq.
+func MapSeq[T, R any](seq iter.Seq[T], mapper func(item T) R) iter.Seq[R] {
+	return func(yield func(R) bool) {
+		for item := range seq {
+			if !yield(mapper(item)) {
+				return
+			}
+		}
+	}
+}
+
+// FlatMapSeq returns an iterator over the values in the slices that result from
+// applying the map function to the elements of seq.
+func FlatMapSeq[T, R any](seq iter.Seq[T], mapper func(item T) []R) iter.Seq[R] {
+	return func(yield func(R) bool) {
+		for item := range seq {
+			for _, r := range mapper(item) {
+				if !yield(r) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// TakeWhileSeq returns an iterator over the elements of seq up to, but not
+// including, the first element that doesn't satisfy the Predicate.
+func TakeWhileSeq[T any](seq iter.Seq[T], pred Predicate[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for item := range seq {
+			if !pred(item) || !yield(item) {
+				return
+			}
+		}
+	}
+}
+
+// DropWhileSeq returns an iterator that skips the elements of seq as long as
+// they satisfy the Predicate, and then yields all the remaining elements.
+func DropWhileSeq[T any](seq iter.Seq[T], pred Predicate[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		dropping := true
+		for item := range seq {
+			if dropping && pred(item) {
+				continue
+			}
+			dropping = false
+			if !yield(item) {
+				return
+			}
+		}
+	}
+}
+
+// LimitSeq returns an iterator over at most the first n elements of seq. Once n
+// elements have been yielded seq is stopped.
+func LimitSeq[T any](seq iter.Seq[T], n int) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if n <= 0 {
+			return
+		}
+		count := 0
+		for item := range seq {
+			if !yield(item) {
+				return
+			}
+			count++
+			if count >= n {
+				return
+			}
+		}
+	}
+}
+
+// SkipSeq returns an iterator that skips the first n elements of seq.
+func SkipSeq[T any](seq iter.Seq[T], n int) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		skipped := 0
+		for item := range seq {
+			if skipped < n {
+				skipped++
+				continue
+			}
+			if !yield(item) {
+				return
+			}
+		}
+	}
+}
+
+// ChunkSeq returns an iterator over chunks of seq with a max length of the
+// provided size. If seq cannot be split evenly the last chunk contains all the
+// remaining elements. Each chunk is a new slice.
+//
+// Providing a size less than 1 will result in a panic.
+func ChunkSeq[T any](seq iter.Seq[T], size int) iter.Seq[[]T] {
+	if size < 1 {
+		panic(fmt.Errorf("illegal size, cannot create chunks whose size is less than 1"))
+	}
+	return func(yield func([]T) bool) {
+		chunk := make([]T, 0, size)
+		for item := range seq {
+			chunk = append(chunk, item)
+			if len(chunk) == size {
+				if !yield(chunk) {
+					return
+				}
+				chunk = make([]T, 0, size)
+			}
+		}
+		if len(chunk) > 0 {
+			yield(chunk)
+		}
+	}
+}
+
+// ZipSeq returns an iterator over Pairs of the elements of left and right.
+// Unlike Zip the iterators don't need to be the same length, the iterator stops
+// as soon as either of them is exhausted.
+func ZipSeq[T, U any](left iter.Seq[T], right iter.Seq[U]) iter.Seq[Pair[T, U]] {
+	return func(yield func(Pair[T, U]) bool) {
+		nextRight, stop := iter.Pull(right)
+		defer stop()
+		for l := range left {
+			r, ok := nextRight()
+			if !ok || !yield(Pair[T, U]{First: l, Second: r}) {
+				return
+			}
+		}
+	}
+}
+
+// UniqueSeq returns an iterator over the elements of seq without duplicates,
+// only the first occurrence of each element is yielded.
+func UniqueSeq[T comparable](seq iter.Seq[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		seen := make(map[T]struct{})
+		for item := range seq {
+			if _, ok := seen[item]; ok {
+				continue
+			}
+			seen[item] = struct{}{}
+			if !yield(item) {
+				return
+			}
+		}
+	}
+}
+
+// ReduceSeq consumes the iterator reducing it to a value that is accumulated by
+// iterating over each element.
+func ReduceSeq[T, R any](seq iter.Seq[T], accum Accumulator[T, R], val R) R {
+	for item := range seq {
+		val = accum(val, item)
+	}
+	return val
+}
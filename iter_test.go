@@ -0,0 +1,194 @@
+package slices
+
+import (
+	"iter"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAll(t *testing.T) {
+	var indexes []int
+	var items []string
+	for i, item := range All([]string{"a", "b", "c"}) {
+		indexes = append(indexes, i)
+		items = append(items, item)
+	}
+	assert.Equal(t, []int{0, 1, 2}, indexes)
+	assert.Equal(t, []string{"a", "b", "c"}, items)
+
+	for i := range All([]string{"a", "b", "c"}) {
+		if i == 1 {
+			break
+		}
+		assert.Equal(t, 0, i)
+	}
+}
+
+func TestCollect(t *testing.T) {
+	tests := []struct {
+		name     string
+		seq      iter.Seq[int]
+		expected []int
+	}{
+		{
+			name:     "Values",
+			seq:      Values([]int{1, 2, 3}),
+			expected: []int{1, 2, 3},
+		},
+		{
+			name:     "Nil Slice",
+			seq:      Values[int](nil),
+			expected: []int{},
+		},
+		{
+			name:     "Filter",
+			seq:      FilterSeq(Values(generateDataSet(10)), isEven),
+			expected: []int{0, 2, 4, 6, 8},
+		},
+		{
+			name: "Map",
+			seq: MapSeq(Values([]int{1, 2, 3}), func(i int) int {
+				return i * i
+			}),
+			expected: []int{1, 4, 9},
+		},
+		{
+			name: "Flat Map",
+			seq: FlatMapSeq(Values([]int{1, 2, 3}), func(i int) []int {
+				return []int{i, i * 10}
+			}),
+			expected: []int{1, 10, 2, 20, 3, 30},
+		},
+		{
+			name: "Take While",
+			seq: TakeWhileSeq(Values([]int{1, 2, 3, 10, 1}), func(i int) bool {
+				return i < 5
+			}),
+			expected: []int{1, 2, 3},
+		},
+		{
+			name: "Drop While",
+			seq: DropWhileSeq(Values([]int{1, 2, 3, 10, 1}), func(i int) bool {
+				return i < 5
+			}),
+			expected: []int{10, 1},
+		},
+		{
+			name:     "Limit",
+			seq:      LimitSeq(Values(generateDataSet(100)), 3),
+			expected: []int{0, 1, 2},
+		},
+		{
+			name:     "Limit Zero",
+			seq:      LimitSeq(Values(generateDataSet(100)), 0),
+			expected: []int{},
+		},
+		{
+			name:     "Skip",
+			seq:      SkipSeq(Values(generateDataSet(5)), 3),
+			expected: []int{3, 4},
+		},
+		{
+			name:     "Unique",
+			seq:      UniqueSeq(Values([]int{3, 1, 3, 2, 1})),
+			expected: []int{3, 1, 2},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, Collect(test.seq))
+		})
+	}
+}
+
+func TestChunkSeq(t *testing.T) {
+	tests := []struct {
+		name     string
+		in       []int
+		size     int
+		expected [][]int
+	}{
+		{
+			name:     "Even Split",
+			in:       []int{1, 2, 3, 4},
+			size:     2,
+			expected: [][]int{{1, 2}, {3, 4}},
+		},
+		{
+			name:     "Uneven Split",
+			in:       []int{1, 2, 3, 4, 5},
+			size:     2,
+			expected: [][]int{{1, 2}, {3, 4}, {5}},
+		},
+		{
+			name:     "Empty",
+			in:       nil,
+			size:     2,
+			expected: [][]int{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, Collect(ChunkSeq(Values(test.in), test.size)))
+		})
+	}
+
+	assert.Panics(t, func() {
+		ChunkSeq(Values([]int{1}), 0)
+	})
+}
+
+func TestZipSeq(t *testing.T) {
+	actual := Collect(ZipSeq(Values([]int{1, 2, 3}), Values([]string{"a", "b"})))
+	assert.Equal(t, []Pair[int, string]{
+		{First: 1, Second: "a"},
+		{First: 2, Second: "b"},
+	}, actual)
+
+	pulled := 0
+	right := MapSeq(Values(generateDataSet(100)), func(i int) string {
+		pulled++
+		return strconv.Itoa(i)
+	})
+	for p := range ZipSeq(Values(generateDataSet(100)), right) {
+		if p.First == 2 {
+			break
+		}
+	}
+	assert.Equal(t, 3, pulled)
+}
+
+func TestSeq_EarlyBreak(t *testing.T) {
+	yielded := 0
+	source := func(yield func(int) bool) {
+		for i := 0; i < 100; i++ {
+			yielded++
+			if !yield(i) {
+				return
+			}
+		}
+	}
+
+	var items []int
+	for i := range MapSeq(FilterSeq(source, isEven), func(i int) int { return i * 10 }) {
+		if len(items) == 3 {
+			break
+		}
+		items = append(items, i)
+	}
+	assert.Equal(t, []int{0, 20, 40}, items)
+	assert.Equal(t, 7, yielded)
+
+	yielded = 0
+	assert.Equal(t, []int{0, 1}, Collect(LimitSeq(source, 2)))
+	assert.Equal(t, 2, yielded)
+
+	sum := ReduceSeq(LimitSeq(source, 5), func(agg, i int) int {
+		return agg + i
+	}, 0)
+	assert.Equal(t, 10, sum)
+}
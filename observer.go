@@ -0,0 +1,172 @@
+package slices
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"time"
+)
+
+// Observer receives callbacks from the parallel functions as they process the
+// elements of a slice, see WithObserver. Callbacks are made concurrently from
+// the workers, so implementations must be safe for concurrent use and should
+// return quickly as they hold up the worker.
+type Observer interface {
+	// ElementStarted is called before the element at the given index is
+	// processed.
+	ElementStarted(index int)
+
+	// ElementFinished is called after the element at the given index has been
+	// processed with how long it took and the error it failed with, which is a
+	// *PanicError if processing the element panicked.
+	ElementFinished(index int, elapsed time.Duration, err error)
+
+	// QueueDepth is called each time a worker picks up more work with the
+	// number of elements that haven't been handed to a worker yet. For
+	// ForEachParallelByKey it's the number of keys instead.
+	QueueDepth(pending int)
+
+	// WorkerUtilization is called each time a worker starts or finishes
+	// processing an element with the number of workers currently processing an
+	// element and the total number of workers.
+	WorkerUtilization(busy, total int)
+}
+
+// WithObserver sets an Observer receiving callbacks as the elements are
+// processed.
+func WithObserver(o Observer) ParallelOption {
+	return func(cfg *parallelConfig) {
+		cfg.observer = o
+	}
+}
+
+// NopObserver is an Observer that does nothing. It can be embedded to
+// implement only some of the methods of Observer.
+type NopObserver struct{}
+
+func (NopObserver) ElementStarted(int) {}
+
+func (NopObserver) ElementFinished(int, time.Duration, error) {}
+
+func (NopObserver) QueueDepth(int) {}
+
+func (NopObserver) WorkerUtilization(int, int) {}
+
+// observation reports to the Observer of a single call to a parallel function.
+type observation struct {
+	observer Observer
+	clock    Clock
+	workers  int
+	busy     int64
+}
+
+func (o *observation) start(index int) time.Time {
+	o.observer.WorkerUtilization(int(atomic.AddInt64(&o.busy, 1)), o.workers)
+	o.observer.ElementStarted(index)
+	return o.clock.Now()
+}
+
+func (o *observation) finish(index int, started time.Time, err error) {
+	o.observer.ElementFinished(index, o.clock.Now().Sub(started), err)
+	o.observer.WorkerUtilization(int(atomic.AddInt64(&o.busy, -1)), o.workers)
+}
+
+// Progress is an Observer that tracks how many elements of a slice have been
+// processed, which can be polled with Snapshot or reported periodically with
+// Report. A Progress is meant to observe a single call to a parallel function.
+type Progress struct {
+	NopObserver
+
+	total     int64
+	processed int64
+	failed    int64
+	clock     Clock
+	started   time.Time
+}
+
+// NewProgress creates a Progress for processing the given total number of
+// elements. The time the elements are being processed for is measured from the
+// creation of the Progress.
+func NewProgress(total int) *Progress {
+	return newProgress(total, systemClock{})
+}
+
+func newProgress(total int, clock Clock) *Progress {
+	return &Progress{
+		total:   int64(total),
+		clock:   clock,
+		started: clock.Now(),
+	}
+}
+
+func (p *Progress) ElementFinished(_ int, _ time.Duration, err error) {
+	if err != nil {
+		atomic.AddInt64(&p.failed, 1)
+	}
+	atomic.AddInt64(&p.processed, 1)
+}
+
+// ProgressSnapshot is the state of a Progress at a point in time.
+type ProgressSnapshot struct {
+	// Processed is the number of elements processed, including those that
+	// failed.
+	Processed int
+	// Failed is the number of elements that failed.
+	Failed int
+	// Total is the total number of elements.
+	Total int
+	// Elapsed is the time since the Progress was created.
+	Elapsed time.Duration
+	// Rate is the number of elements processed per second.
+	Rate float64
+	// ETA is the estimated time remaining until all elements are processed
+	// at the current rate. It's 0 until the first element is processed.
+	ETA time.Duration
+}
+
+// Percent returns the percentage of elements processed.
+func (s ProgressSnapshot) Percent() float64 {
+	if s.Total == 0 {
+		return 100
+	}
+	return float64(s.Processed) / float64(s.Total) * 100
+}
+
+func (s ProgressSnapshot) String() string {
+	return fmt.Sprintf("processed %d/%d (%.1f%%), %d failed, %.1f/s, ETA %s",
+		s.Processed, s.Total, s.Percent(), s.Failed, s.Rate, s.ETA.Round(time.Second))
+}
+
+// Snapshot returns the current state of the Progress.
+func (p *Progress) Snapshot() ProgressSnapshot {
+	s := ProgressSnapshot{
+		Processed: int(atomic.LoadInt64(&p.processed)),
+		Failed:    int(atomic.LoadInt64(&p.failed)),
+		Total:     int(p.total),
+		Elapsed:   p.clock.Now().Sub(p.started),
+	}
+	if s.Elapsed > 0 {
+		s.Rate = float64(s.Processed) / s.Elapsed.Seconds()
+	}
+	if s.Rate > 0 && s.Processed < s.Total {
+		s.ETA = time.Duration(float64(s.Total-s.Processed) / s.Rate * float64(time.Second))
+	}
+	return s
+}
+
+// Report calls fn with a snapshot of the Progress every interval until all the
+// elements have been processed or ctx is cancelled, calling it a final time
+// before returning. It blocks so it's typically run on its own goroutine.
+func (p *Progress) Report(ctx context.Context, interval time.Duration, fn func(ProgressSnapshot)) {
+	for {
+		if err := sleep(ctx, p.clock, interval); err != nil {
+			fn(p.Snapshot())
+			return
+		}
+		s := p.Snapshot()
+		fn(s)
+		if s.Processed >= s.Total {
+			return
+		}
+	}
+}
@@ -0,0 +1,154 @@
+package slices
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type recordingObserver struct {
+	mu         sync.Mutex
+	started    []int
+	finished   map[int]error
+	depths     []int
+	maxBusy    int
+	lastBusy   int
+	totalCount int
+}
+
+func newRecordingObserver() *recordingObserver {
+	return &recordingObserver{finished: make(map[int]error)}
+}
+
+func (o *recordingObserver) ElementStarted(index int) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.started = append(o.started, index)
+}
+
+func (o *recordingObserver) ElementFinished(index int, _ time.Duration, err error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.finished[index] = err
+}
+
+func (o *recordingObserver) QueueDepth(pending int) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.depths = append(o.depths, pending)
+}
+
+func (o *recordingObserver) WorkerUtilization(busy, total int) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if busy > o.maxBusy {
+		o.maxBusy = busy
+	}
+	o.lastBusy = busy
+	o.totalCount = total
+}
+
+func TestWithObserver(t *testing.T) {
+	errBoom := errors.New("boom")
+	obs := newRecordingObserver()
+
+	err := ForEachParallelCtx(context.Background(), generateDataSet(10), func(ctx context.Context, i int) error {
+		if i == 7 {
+			return errBoom
+		}
+		return nil
+	}, 2, WithObserver(obs), WithErrorMode(CollectAll))
+	assert.ErrorIs(t, err, errBoom)
+
+	assert.ElementsMatch(t, generateDataSet(10), obs.started)
+	assert.Len(t, obs.finished, 10)
+	for i, err := range obs.finished {
+		if i == 7 {
+			assert.ErrorIs(t, err, errBoom)
+		} else {
+			assert.NoError(t, err)
+		}
+	}
+	assert.Equal(t, 0, obs.depths[len(obs.depths)-1])
+	assert.LessOrEqual(t, obs.maxBusy, 2)
+	assert.Equal(t, 0, obs.lastBusy)
+	assert.Equal(t, 2, obs.totalCount)
+}
+
+func TestWithObserver_Panic(t *testing.T) {
+	obs := newRecordingObserver()
+
+	assert.Panics(t, func() {
+		ForEachParallel([]int{1, 2, 3}, func(i int) {
+			if i == 2 {
+				panic("kaboom")
+			}
+		}, 1, WithObserver(obs))
+	})
+
+	var pe *PanicError
+	assert.ErrorAs(t, obs.finished[1], &pe)
+	assert.Equal(t, 0, obs.lastBusy)
+}
+
+func TestWithObserver_ByKey(t *testing.T) {
+	obs := newRecordingObserver()
+	events := generateAccountEvents([]string{"alice", "bob"}, 3)
+
+	ForEachParallelByKey(events, func(e accountEvent) string {
+		return e.account
+	}, func(e accountEvent) {}, 2, WithObserver(obs))
+
+	assert.ElementsMatch(t, generateDataSet(len(events)), obs.started)
+	assert.Len(t, obs.finished, len(events))
+}
+
+func TestProgress(t *testing.T) {
+	clock := newFakeClock()
+	p := newProgress(100, clock)
+
+	assert.Equal(t, ProgressSnapshot{Total: 100}, p.Snapshot())
+
+	for i := 0; i < 25; i++ {
+		p.ElementFinished(i, time.Millisecond, nil)
+	}
+	p.ElementFinished(25, time.Millisecond, errors.New("boom"))
+	clock.Advance(13 * time.Second)
+
+	s := p.Snapshot()
+	assert.Equal(t, 26, s.Processed)
+	assert.Equal(t, 1, s.Failed)
+	assert.Equal(t, 13*time.Second, s.Elapsed)
+	assert.Equal(t, 2.0, s.Rate)
+	assert.Equal(t, 37*time.Second, s.ETA)
+	assert.Equal(t, 26.0, s.Percent())
+	assert.Equal(t, "processed 26/100 (26.0%), 1 failed, 2.0/s, ETA 37s", s.String())
+}
+
+func TestProgress_Report(t *testing.T) {
+	clock := newFakeClock()
+	p := newProgress(4, clock)
+
+	var reports []int
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		p.Report(context.Background(), time.Second, func(s ProgressSnapshot) {
+			reports = append(reports, s.Processed)
+		})
+	}()
+
+	for i := 0; i < 2; i++ {
+		clock.WaitForTimers(1)
+		p.ElementFinished(i*2, 0, nil)
+		p.ElementFinished(i*2+1, 0, nil)
+		clock.Advance(time.Second)
+	}
+	<-done
+
+	assert.Equal(t, []int{2, 4}, reports)
+}
@@ -0,0 +1,591 @@
+package slices
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime/debug"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// ErrorMode determines how the error returning parallel functions behave when
+// processing an element fails.
+type ErrorMode int
+
+const (
+	// FailFast cancels all remaining work as soon as processing an element
+	// returns an error, and that error is returned.
+	FailFast ErrorMode = iota
+
+	// CollectAll processes every element regardless of failures and returns all
+	// the errors joined together, ordered by element index.
+	CollectAll
+)
+
+// ParallelOption configures the behavior of the parallel functions.
+type ParallelOption func(cfg *parallelConfig)
+
+type parallelConfig struct {
+	errorMode        ErrorMode
+	batchSize        int
+	defaultBatchSize int
+	panicAsError     bool
+	executor         *Executor
+	scheduler        Scheduler
+	clock            Clock
+	rateLimit        float64
+	rateBurst        int
+	retry            *RetryPolicy
+	timeout          time.Duration
+	observer         Observer
+
+	// limiter is created from the options for each call of a parallel function.
+	limiter *tokenBucket
+
+	// obs is created by run for each call of a parallel function when an
+	// Observer is set. When the tasks passed to run are groups of elements the
+	// function processing the group reports each element to it instead of run.
+	obs          *observation
+	groupedTasks bool
+}
+
+// newParallelConfig creates a parallelConfig from the options. The batchSize is
+// the default number of elements the SharedQueue Scheduler hands to a worker at
+// a time, 0 meaning it is derived from the length of the input and the
+// parallelism.
+func newParallelConfig(batchSize int, opts []ParallelOption) *parallelConfig {
+	cfg := &parallelConfig{
+		errorMode:        FailFast,
+		defaultBatchSize: batchSize,
+		executor:         DefaultExecutor(),
+		scheduler:        SharedQueue,
+		clock:            systemClock{},
+	}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+	if cfg.rateLimit > 0 {
+		cfg.limiter = newTokenBucket(cfg.clock, cfg.rateLimit, cfg.rateBurst)
+	}
+	return cfg
+}
+
+// newPlainConfig creates the parallelConfig for the parallel functions whose
+// function can't return an error. Panics are always raised on the caller, and
+// as the function can't fail or be cancelled WithRetry and WithTimeout are
+// rejected rather than silently ignored.
+func newPlainConfig(batchSize int, opts []ParallelOption) *parallelConfig {
+	cfg := newParallelConfig(batchSize, opts)
+	cfg.panicAsError = false
+	if cfg.retry != nil || cfg.timeout > 0 {
+		panic(fmt.Errorf("WithRetry and WithTimeout are only supported by the parallel functions that return an error"))
+	}
+	return cfg
+}
+
+// throttle waits for the rate limiter, if any, before the function passed to
+// one of the parallel functions that don't return an error is called. It
+// returns false if the job was cancelled while waiting.
+func (cfg *parallelConfig) throttle(ctx context.Context) bool {
+	return cfg.limiter == nil || cfg.limiter.wait(ctx) == nil
+}
+
+// batchSizeFor returns the number of elements handed to a worker at a time.
+func (cfg *parallelConfig) batchSizeFor(n, parallelism int) int {
+	if cfg.batchSize > 0 {
+		return cfg.batchSize
+	}
+	if cfg.scheduler == SharedQueue && cfg.defaultBatchSize > 0 {
+		return cfg.defaultBatchSize
+	}
+	// Aim for several batches per worker so a slow batch doesn't leave the
+	// other workers idle for long.
+	size := n / (parallelism * 8)
+	if size < 1 {
+		size = 1
+	}
+	return size
+}
+
+// WithErrorMode sets the ErrorMode used by the error returning parallel
+// functions. The default is FailFast.
+func WithErrorMode(mode ErrorMode) ParallelOption {
+	return func(cfg *parallelConfig) {
+		cfg.errorMode = mode
+	}
+}
+
+// WithBatchSize sets the number of consecutive elements handed to a worker at
+// a time, or taken at a time from its own range with the WorkStealing
+// Scheduler. Larger batches reduce the coordination overhead between workers
+// when the work done per element is cheap, at the cost of balancing the load
+// across workers less evenly. A size less than 1 is ignored.
+func WithBatchSize(size int) ParallelOption {
+	return func(cfg *parallelConfig) {
+		if size > 0 {
+			cfg.batchSize = size
+		}
+	}
+}
+
+// WithPanicAsError converts panics raised while processing an element into a
+// *PanicError that is handled like any other error, rather than raising the
+// panic again on the calling goroutine. It only applies to the parallel
+// functions that return an error.
+func WithPanicAsError() ParallelOption {
+	return func(cfg *parallelConfig) {
+		cfg.panicAsError = true
+	}
+}
+
+// WithExecutor sets the Executor the workers are run on. By default the
+// DefaultExecutor is used. A nil Executor starts new goroutines for the workers
+// on every call.
+func WithExecutor(e *Executor) ParallelOption {
+	return func(cfg *parallelConfig) {
+		cfg.executor = e
+	}
+}
+
+// ElementError is the error returned by the parallel functions when processing
+// an element fails. It records the index of the element in the input slice
+// alongside the original error.
+type ElementError struct {
+	Index int
+	Err   error
+}
+
+func (e *ElementError) Error() string {
+	return fmt.Sprintf("element %d: %v", e.Index, e.Err)
+}
+
+func (e *ElementError) Unwrap() error {
+	return e.Err
+}
+
+// unwrap returns the original error, or a nil error if e is nil.
+func (e *ElementError) unwrap() error {
+	if e == nil {
+		return nil
+	}
+	return e.Err
+}
+
+// PanicError is raised, or returned when WithPanicAsError is used, when
+// processing an element in one of the parallel functions panics. When raised
+// on the calling goroutine the Stack records where the panic originally
+// occurred in the worker goroutine.
+type PanicError struct {
+	Value any
+	Index int
+	Stack []byte
+}
+
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("panic processing element %d: %v\n\n%s", e.Index, e.Value, e.Stack)
+}
+
+// Unwrap returns the value the panic was raised with if it was an error.
+func (e *PanicError) Unwrap() error {
+	if err, ok := e.Value.(error); ok {
+		return err
+	}
+	return nil
+}
+
+// elementPanic is raised by functions run as a single task by the job which
+// process several elements, so the *PanicError records the index of the element
+// that panicked rather than the index of the task.
+type elementPanic struct {
+	value any
+	index int
+	stack []byte
+}
+
+// newPanicError creates a *PanicError for a panic recovered while processing
+// the element at the given index. It must be called from the deferred function
+// that recovered the panic for the stack to include where it was raised.
+func newPanicError(v any, index int) *PanicError {
+	if ep, ok := v.(elementPanic); ok {
+		return &PanicError{Value: ep.value, Index: ep.index, Stack: ep.stack}
+	}
+	return &PanicError{Value: v, Index: index, Stack: debug.Stack()}
+}
+
+// ForEachParallel iterates through a slice in parallel using the specified
+// amount of parallelism.
+//
+// If fn panics no further elements are processed and once all the workers have
+// stopped the panic is raised again on the calling goroutine as a *PanicError.
+func ForEachParallel[T any](slice []T, fn func(T), parallelism int, opts ...ParallelOption) {
+	cfg := newPlainConfig(1, opts)
+	_ = run(context.Background(), len(slice), parallelism, cfg,
+		func(ctx context.Context, i int) *ElementError {
+			if !cfg.throttle(ctx) {
+				return nil
+			}
+			fn(slice[i])
+			return nil
+		})
+}
+
+// ForEachParallelCtx iterates through a slice in parallel using the specified
+// amount of parallelism, passing each element to fn along with a Context.
+//
+// In FailFast mode, which is the default, the Context passed to fn is cancelled
+// as soon as any invocation returns an error, no further elements are processed
+// and the first error is returned as an *ElementError. In CollectAll mode every
+// element is processed and all errors are returned joined together with
+// errors.Join, each wrapped in an *ElementError.
+//
+// If ctx is cancelled no further elements are processed and the error of ctx
+// is returned.
+//
+// Panics are handled the same way as ForEachParallel handles them unless the
+// WithPanicAsError option is used.
+func ForEachParallelCtx[T any](ctx context.Context, slice []T, fn func(context.Context, T) error, parallelism int, opts ...ParallelOption) error {
+	cfg := newParallelConfig(1, opts)
+	return run(ctx, len(slice), parallelism, cfg,
+		func(ctx context.Context, i int) *ElementError {
+			err := cfg.invoke(ctx, func(ctx context.Context) error {
+				return fn(ctx, slice[i])
+			})
+			if err != nil {
+				return &ElementError{Index: i, Err: err}
+			}
+			return nil
+		})
+}
+
+// MapParallel creates a new slice mapping the values that result from applying
+// the map function to each element in parallel using the specified amount of
+// parallelism. The order of the results matches the order of the input slice.
+//
+// Elements are handed to the workers in batches rather than one at a time to
+// keep the overhead low when mapper is cheap, see WithBatchSize. Panics are
+// handled the same way as ForEachParallel handles them.
+func MapParallel[T, R any](slice []T, mapper func(item T) R, parallelism int, opts ...ParallelOption) []R {
+	cfg := newPlainConfig(0, opts)
+	results := make([]R, len(slice))
+	_ = run(context.Background(), len(slice), parallelism, cfg,
+		func(ctx context.Context, i int) *ElementError {
+			if !cfg.throttle(ctx) {
+				return nil
+			}
+			results[i] = mapper(slice[i])
+			return nil
+		})
+	return results
+}
+
+// MapParallelErr is like MapParallel but the map function accepts a Context
+// and may return an error. Errors are handled the same way as ForEachParallelCtx
+// handles them. If an error occurs the results are discarded and nil is returned
+// along with the error.
+func MapParallelErr[T, R any](ctx context.Context, slice []T, mapper func(context.Context, T) (R, error), parallelism int, opts ...ParallelOption) ([]R, error) {
+	results := make([]R, len(slice))
+	cfg := newParallelConfig(0, opts)
+	err := run(ctx, len(slice), parallelism, cfg,
+		func(ctx context.Context, i int) *ElementError {
+			err := cfg.invoke(ctx, func(ctx context.Context) error {
+				res, err := mapper(ctx, slice[i])
+				if err == nil {
+					results[i] = res
+				}
+				return err
+			})
+			if err != nil {
+				return &ElementError{Index: i, Err: err}
+			}
+			return nil
+		})
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// FilterParallel returns a new slice containing all the elements that satisfied
+// the Predicate, evaluating the Predicate in parallel using the specified amount
+// of parallelism. The order of the elements matches the order of the input
+// slice. Panics are handled the same way as ForEachParallel handles them.
+func FilterParallel[T any](slice []T, pred Predicate[T], parallelism int, opts ...ParallelOption) []T {
+	size, cfg := chunkedConfig(len(slice), parallelism, opts)
+	filtered := make([][]T, (len(slice)+size-1)/size)
+	_ = run(context.Background(), len(slice), parallelism, cfg,
+		func(ctx context.Context, i int) *ElementError {
+			if !cfg.throttle(ctx) {
+				return nil
+			}
+			if pred(slice[i]) {
+				filtered[i/size] = append(filtered[i/size], slice[i])
+			}
+			return nil
+		})
+	return Flatten(filtered)
+}
+
+// ReduceParallel reduces a slice to a value in parallel using the specified
+// amount of parallelism. The slice is split into contiguous chunks that are each
+// reduced with accum starting from identity, the partial results are then
+// combined in order using combine.
+//
+// For the result to match Reduce, identity must not change a value when
+// combined with it and combine must be associative. Panics are handled the same
+// way as ForEachParallel handles them.
+func ReduceParallel[T, R any](slice []T, accum Accumulator[T, R], combine func(a, b R) R, identity R, parallelism int, opts ...ParallelOption) R {
+	size, cfg := chunkedConfig(len(slice), parallelism, opts)
+	if len(slice) == 0 {
+		return identity
+	}
+	partials := make([]R, (len(slice)+size-1)/size)
+	for i := range partials {
+		partials[i] = identity
+	}
+	_ = run(context.Background(), len(slice), parallelism, cfg,
+		func(ctx context.Context, i int) *ElementError {
+			if !cfg.throttle(ctx) {
+				return nil
+			}
+			partials[i/size] = accum(partials[i/size], slice[i])
+			return nil
+		})
+	return Reduce(partials[1:], combine, partials[0])
+}
+
+// chunkedConfig returns the configuration for the parallel functions that
+// accumulate partial results per chunk of the input along with the chunk size.
+// Each chunk is handed to a worker as a single batch by the SharedQueue
+// Scheduler, so the elements of a chunk are always processed in order by the
+// same worker.
+func chunkedConfig(n, parallelism int, opts []ParallelOption) (int, *parallelConfig) {
+	checkParallelism(parallelism)
+	cfg := newPlainConfig(0, opts)
+	cfg.scheduler = SharedQueue
+	cfg.batchSize = cfg.batchSizeFor(n, parallelism)
+	return cfg.batchSize, cfg
+}
+
+// span is a half-open range [lo, hi) of indexes handed to a worker.
+type span struct {
+	lo, hi int
+}
+
+// run invokes fn for every index in [0, n) using the given amount of
+// parallelism. Errors returned by fn are handled according to the ErrorMode in
+// cfg. If fn panics the remaining work is cancelled and the panic is raised
+// again on the calling goroutine as a *PanicError, unless cfg converts panics
+// to errors.
+func run(ctx context.Context, n int, parallelism int, cfg *parallelConfig, fn func(context.Context, int) *ElementError) error {
+	checkParallelism(parallelism)
+	if n == 0 {
+		return ctx.Err()
+	}
+	if parallelism > n {
+		parallelism = n
+	}
+
+	if cfg.observer != nil {
+		cfg.obs = &observation{
+			observer: cfg.observer,
+			clock:    cfg.clock,
+			workers:  parallelism,
+		}
+	}
+	j := newJob(ctx, cfg, fn)
+	defer j.cancel()
+
+	sched := newScheduler(cfg, n, parallelism)
+	worker := func(w int) {
+		for !j.stopped.Load() {
+			sp, ok := sched.next(w)
+			if !ok {
+				return
+			}
+			if cfg.obs != nil {
+				cfg.obs.observer.QueueDepth(sched.pending())
+			}
+			j.process(sp)
+		}
+	}
+
+	// The calling goroutine is one of the workers
+	wg := sync.WaitGroup{}
+	wg.Add(parallelism - 1)
+	for w := 1; w < parallelism; w++ {
+		cfg.executor.execute(func() {
+			defer wg.Done()
+			worker(w)
+		})
+	}
+	worker(0)
+
+	wg.Wait()
+	return j.result()
+}
+
+// job holds the state shared by the workers processing a single call to one of
+// the parallel functions.
+type job struct {
+	parent context.Context
+	ctx    context.Context
+	cancel context.CancelFunc
+	cfg    *parallelConfig
+	fn     func(context.Context, int) *ElementError
+	obs    *observation
+
+	// stopped is set once the job is cancelled, checking it is a lot cheaper
+	// than checking the done channel of ctx before every element.
+	stopped atomic.Bool
+
+	// parentDone is the done channel of the parent Context, which is nil when
+	// the parent can never be cancelled.
+	parentDone <-chan struct{}
+
+	mu       sync.Mutex
+	errs     []*ElementError
+	panicked *PanicError
+}
+
+func newJob(parent context.Context, cfg *parallelConfig, fn func(context.Context, int) *ElementError) *job {
+	ctx, cancel := context.WithCancel(parent)
+	j := &job{
+		parent: parent,
+		ctx:    ctx,
+		cancel: cancel,
+		cfg:    cfg,
+		fn:     fn,
+
+		parentDone: parent.Done(),
+	}
+	if !cfg.groupedTasks {
+		j.obs = cfg.obs
+	}
+	return j
+}
+
+// stop cancels the job, no further elements are processed.
+func (j *job) stop() {
+	j.stopped.Store(true)
+	j.cancel()
+}
+
+// process invokes fn for every index in the span. Once the job has been
+// cancelled the remaining indexes are skipped.
+func (j *job) process(sp span) {
+	for i := sp.lo; i < sp.hi; {
+		i = j.processFrom(i, sp.hi)
+	}
+}
+
+// processFrom invokes fn for the indexes in [i, hi) until fn panics, returning
+// the index processing should resume from. A single deferred recover covers the
+// whole range to keep the cost per element low.
+func (j *job) processFrom(i, hi int) (next int) {
+	var started time.Time
+	defer func() {
+		if v := recover(); v != nil {
+			pe := newPanicError(v, i)
+			if j.obs != nil {
+				j.obs.finish(i, started, pe)
+			}
+			if j.cfg.panicAsError {
+				// pe.Index is the index of the element that panicked, which
+				// differs from i when the task processes a group of elements.
+				j.fail(&ElementError{Index: pe.Index, Err: pe})
+				next = i + 1
+				return
+			}
+			j.panic(pe)
+			next = hi
+		}
+	}()
+	for ; i < hi; i++ {
+		// The job stops itself by setting stopped, so the done channel only
+		// needs to be checked when the parent Context can be cancelled.
+		if j.stopped.Load() || (j.parentDone != nil && j.isDone()) {
+			return hi
+		}
+		if j.obs != nil {
+			started = j.obs.start(i)
+		}
+		err := j.fn(j.ctx, i)
+		if j.obs != nil {
+			j.obs.finish(i, started, err.unwrap())
+		}
+		if err != nil {
+			j.fail(err)
+		}
+	}
+	return hi
+}
+
+// isDone reports whether the parent Context has been cancelled without
+// blocking, stopping the job if it was.
+func (j *job) isDone() bool {
+	select {
+	case <-j.parentDone:
+		j.stopped.Store(true)
+		return true
+	default:
+		return false
+	}
+}
+
+func (j *job) fail(err *ElementError) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	if j.cfg.errorMode == FailFast {
+		if len(j.errs) == 0 {
+			j.errs = append(j.errs, err)
+		}
+		j.stop()
+		return
+	}
+	j.errs = append(j.errs, err)
+}
+
+func (j *job) panic(pe *PanicError) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	if j.panicked == nil {
+		j.panicked = pe
+	}
+	j.stop()
+}
+
+// result returns the outcome of the job once all the workers have finished,
+// raising a recovered panic on the calling goroutine.
+func (j *job) result() error {
+	if j.panicked != nil {
+		panic(j.panicked)
+	}
+
+	if j.cfg.errorMode == FailFast {
+		if len(j.errs) > 0 {
+			return j.errs[0]
+		}
+		return j.parent.Err()
+	}
+
+	sort.Slice(j.errs, func(a, b int) bool {
+		return j.errs[a].Index < j.errs[b].Index
+	})
+	joined := make([]error, 0, len(j.errs)+1)
+	for _, err := range j.errs {
+		joined = append(joined, err)
+	}
+	if err := j.parent.Err(); err != nil {
+		joined = append(joined, err)
+	}
+	return errors.Join(joined...)
+}
+
+func checkParallelism(parallelism int) {
+	if parallelism < 1 {
+		panic(fmt.Errorf("parallelism less than 0 not permitted"))
+	}
+}
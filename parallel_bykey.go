@@ -0,0 +1,105 @@
+package slices
+
+import (
+	"context"
+	"runtime/debug"
+	"time"
+)
+
+// ForEachParallelByKey iterates through a slice in parallel using the specified
+// amount of parallelism, guaranteeing elements that share the same key generated
+// by keyFn are processed one at a time in the order they appear in the slice.
+// Elements with different keys are spread across the workers and processed
+// concurrently.
+//
+// The elements of each key are processed as a single unit of work, so the
+// parallelism is limited by the number of distinct keys. Panics are handled the
+// same way as ForEachParallel handles them.
+func ForEachParallelByKey[T any, K comparable](slice []T, keyFn func(item T) K, fn func(T), parallelism int, opts ...ParallelOption) {
+	cfg := newPlainConfig(1, opts)
+	cfg.groupedTasks = true
+	groups := groupIndexesBy(slice, keyFn)
+	_ = run(context.Background(), len(groups), parallelism, cfg,
+		func(ctx context.Context, g int) *ElementError {
+			return processGroup(ctx, cfg, groups[g], func(ctx context.Context, i int) error {
+				if !cfg.throttle(ctx) {
+					return nil
+				}
+				fn(slice[i])
+				return nil
+			})
+		})
+}
+
+// ForEachParallelByKeyCtx is like ForEachParallelByKey but fn accepts a Context
+// and may return an error. Errors and panics are handled the same way as
+// ForEachParallelCtx handles them, except that in CollectAll mode once an
+// element fails the remaining elements with the same key are skipped, as they
+// may depend on the failed element having been processed.
+func ForEachParallelByKeyCtx[T any, K comparable](ctx context.Context, slice []T, keyFn func(item T) K, fn func(context.Context, T) error, parallelism int, opts ...ParallelOption) error {
+	groups := groupIndexesBy(slice, keyFn)
+	cfg := newParallelConfig(1, opts)
+	cfg.groupedTasks = true
+	return run(ctx, len(groups), parallelism, cfg,
+		func(ctx context.Context, g int) *ElementError {
+			return processGroup(ctx, cfg, groups[g], func(ctx context.Context, i int) error {
+				return cfg.invoke(ctx, func(ctx context.Context) error {
+					return fn(ctx, slice[i])
+				})
+			})
+		})
+}
+
+// processGroup invokes fn for the indexes of a group in order, stopping at the
+// first error or once ctx is cancelled.
+func processGroup(ctx context.Context, cfg *parallelConfig, indexes []int, fn func(context.Context, int) error) *ElementError {
+	current := -1
+	var started time.Time
+	defer func() {
+		if v := recover(); v != nil {
+			ep := elementPanic{value: v, index: current, stack: debug.Stack()}
+			if cfg.obs != nil {
+				cfg.obs.finish(current, started, &PanicError{Value: ep.value, Index: ep.index, Stack: ep.stack})
+			}
+			panic(ep)
+		}
+	}()
+	for _, i := range indexes {
+		if ctx.Err() != nil {
+			return nil
+		}
+		current = i
+		if cfg.obs != nil {
+			started = cfg.obs.start(i)
+		}
+		err := fn(ctx, i)
+		if cfg.obs != nil {
+			cfg.obs.finish(i, started, err)
+		}
+		if err != nil {
+			return &ElementError{Index: i, Err: err}
+		}
+	}
+	return nil
+}
+
+// groupIndexesBy groups the indexes of the elements of a slice by the key
+// generated from the keyFn function. The groups are ordered by the first
+// occurrence of their key, and the indexes within a group are in ascending
+// order.
+func groupIndexesBy[T any, K comparable](in []T, keyFn func(item T) K) [][]int {
+	groups := make([][]int, 0)
+	seen := make(map[K]int)
+
+	for i, item := range in {
+		key := keyFn(item)
+		g, ok := seen[key]
+		if !ok {
+			g = len(groups)
+			seen[key] = g
+			groups = append(groups, make([]int, 0, 1))
+		}
+		groups[g] = append(groups[g], i)
+	}
+	return groups
+}
@@ -0,0 +1,144 @@
+package slices
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type accountEvent struct {
+	account string
+	seq     int
+}
+
+func generateAccountEvents(accounts []string, perAccount int) []accountEvent {
+	events := make([]accountEvent, 0, len(accounts)*perAccount)
+	for seq := 0; seq < perAccount; seq++ {
+		for _, account := range accounts {
+			events = append(events, accountEvent{account: account, seq: seq})
+		}
+	}
+	return events
+}
+
+func TestForEachParallelByKey(t *testing.T) {
+	accounts := []string{"alice", "bob", "carol", "dave", "erin"}
+	events := generateAccountEvents(accounts, 200)
+
+	var mu sync.Mutex
+	processed := make(map[string][]int)
+	ForEachParallelByKey(events, func(e accountEvent) string {
+		return e.account
+	}, func(e accountEvent) {
+		mu.Lock()
+		defer mu.Unlock()
+		processed[e.account] = append(processed[e.account], e.seq)
+	}, 3)
+
+	assert.Len(t, processed, len(accounts))
+	for _, account := range accounts {
+		assert.Equal(t, generateDataSet(200), processed[account], account)
+	}
+}
+
+func TestForEachParallelByKey_Panic(t *testing.T) {
+	events := generateAccountEvents([]string{"alice", "bob"}, 3)
+
+	defer func() {
+		pe, ok := recover().(*PanicError)
+		if assert.True(t, ok) {
+			// bob's event with seq 1 is at index 3
+			assert.Equal(t, 3, pe.Index)
+			assert.Equal(t, "kaboom", pe.Value)
+		}
+	}()
+
+	ForEachParallelByKey(events, func(e accountEvent) string {
+		return e.account
+	}, func(e accountEvent) {
+		if e.account == "bob" && e.seq == 1 {
+			panic("kaboom")
+		}
+	}, 2)
+	t.Error("expected ForEachParallelByKey to panic")
+}
+
+func TestForEachParallelByKeyCtx(t *testing.T) {
+	errBoom := errors.New("boom")
+	events := generateAccountEvents([]string{"alice", "bob", "carol"}, 4)
+
+	var mu sync.Mutex
+	processed := make(map[string][]int)
+	err := ForEachParallelByKeyCtx(context.Background(), events, func(e accountEvent) string {
+		return e.account
+	}, func(ctx context.Context, e accountEvent) error {
+		if e.account == "bob" && e.seq == 1 {
+			return errBoom
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		processed[e.account] = append(processed[e.account], e.seq)
+		return nil
+	}, 2, WithErrorMode(CollectAll))
+
+	var elemErr *ElementError
+	assert.ErrorAs(t, err, &elemErr)
+	assert.ErrorIs(t, err, errBoom)
+	assert.Equal(t, 4, elemErr.Index)
+
+	// The events of bob after the failed one are skipped
+	assert.Equal(t, map[string][]int{
+		"alice": {0, 1, 2, 3},
+		"bob":   {0},
+		"carol": {0, 1, 2, 3},
+	}, processed)
+}
+
+func TestForEachParallelByKeyCtx_PanicAsError(t *testing.T) {
+	errBoom := errors.New("boom")
+	events := generateAccountEvents([]string{"alice", "bob"}, 4)
+
+	err := ForEachParallelByKeyCtx(context.Background(), events, func(e accountEvent) string {
+		return e.account
+	}, func(ctx context.Context, e accountEvent) error {
+		switch {
+		case e.account == "alice" && e.seq == 1:
+			return errBoom
+		case e.account == "bob" && e.seq == 2:
+			panic("kaboom")
+		}
+		return nil
+	}, 2, WithPanicAsError(), WithErrorMode(CollectAll))
+
+	joined, ok := err.(interface{ Unwrap() []error })
+	if !assert.True(t, ok) {
+		return
+	}
+	errs := joined.Unwrap()
+	if !assert.Len(t, errs, 2) {
+		return
+	}
+
+	var elemErr *ElementError
+	assert.ErrorAs(t, errs[0], &elemErr)
+	assert.Equal(t, 2, elemErr.Index)
+	assert.ErrorIs(t, errs[0], errBoom)
+
+	var pe *PanicError
+	assert.ErrorAs(t, errs[1], &elemErr)
+	assert.Equal(t, 5, elemErr.Index)
+	assert.ErrorAs(t, errs[1], &pe)
+	assert.Equal(t, 5, pe.Index)
+	assert.Equal(t, "kaboom", pe.Value)
+}
+
+func TestGroupIndexesBy(t *testing.T) {
+	in := []string{"apple", "banana", "avocado", "cherry", "blueberry"}
+	actual := groupIndexesBy(in, func(s string) byte {
+		return s[0]
+	})
+	assert.Equal(t, [][]int{{0, 2}, {1, 4}, {3}}, actual)
+}
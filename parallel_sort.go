@@ -0,0 +1,194 @@
+package slices
+
+import (
+	"context"
+	"fmt"
+	"sort"
+)
+
+// parallelSortThreshold is the size of the slice, per worker, below which
+// ParallelSort sorts on the calling goroutine since starting the workers would
+// cost more than it saves.
+const parallelSortThreshold = 4096
+
+// ParallelSort sorts the slice in place in the order determined by less using
+// a parallel merge sort with the specified amount of parallelism. The slice is
+// split into one contiguous run per worker, each run is sorted in parallel and
+// the runs are then merged in parallel, using a buffer the size of the slice.
+// Small slices are sorted on the calling goroutine.
+//
+// The sort isn't stable. Panics raised by less are handled the same way as
+// ForEachParallel handles them, also when the slice is sorted on the calling
+// goroutine, and are raised again as a *PanicError. Rate limiting, retries and timeouts don't apply
+// to comparisons, using WithRateLimit, WithRetry or WithTimeout will result in
+// a panic.
+func ParallelSort[T any](in []T, less func(a, b T) bool, parallelism int, opts ...ParallelOption) {
+	checkParallelism(parallelism)
+	cfg := newPlainConfig(1, opts)
+	if cfg.limiter != nil {
+		panic(fmt.Errorf("WithRateLimit is not supported by ParallelSort"))
+	}
+	if parallelism == 1 || len(in) < parallelSortThreshold*2 {
+		// Match the parallel path, where a panic in less is raised again as a
+		// *PanicError for the run that was being sorted.
+		defer func() {
+			if v := recover(); v != nil {
+				panic(newPanicError(v, 0))
+			}
+		}()
+		sort.Sort(lessSlice[T]{items: in, less: less})
+		return
+	}
+	if maxRuns := len(in) / parallelSortThreshold; parallelism > maxRuns {
+		parallelism = maxRuns
+	}
+
+	runs := make([]span, parallelism)
+	size, rem := len(in)/parallelism, len(in)%parallelism
+	lo := 0
+	for i := range runs {
+		hi := lo + size
+		if i < rem {
+			hi++
+		}
+		runs[i] = span{lo: lo, hi: hi}
+		lo = hi
+	}
+	_ = run(context.Background(), len(runs), parallelism, cfg,
+		func(_ context.Context, i int) *ElementError {
+			sort.Sort(lessSlice[T]{items: in[runs[i].lo:runs[i].hi], less: less})
+			return nil
+		})
+
+	src, dst := in, make([]T, len(in))
+	for len(runs) > 1 {
+		tasks := mergeTasks(src, runs, parallelism, less)
+		_ = run(context.Background(), len(tasks), parallelism, cfg,
+			func(_ context.Context, i int) *ElementError {
+				mergeSpans(tasks[i], src, dst, less)
+				return nil
+			})
+
+		merged := make([]span, 0, (len(runs)+1)/2)
+		for i := 0; i < len(runs); i += 2 {
+			sp := runs[i]
+			if i+1 < len(runs) {
+				sp.hi = runs[i+1].hi
+			}
+			merged = append(merged, sp)
+		}
+		runs = merged
+		src, dst = dst, src
+	}
+	if &src[0] != &in[0] {
+		copy(in, src)
+	}
+}
+
+// mergeTask merges the sorted spans a and b of the source into the destination
+// starting at index out. A span left without a partner has an empty b.
+type mergeTask struct {
+	a, b span
+	out  int
+}
+
+// mergeTasks pairs up adjacent runs to be merged. When there are fewer pairs
+// than workers each merge is split into several tasks, so the last rounds of
+// the sort, which merge a few long runs, still use all the workers.
+func mergeTasks[T any](src []T, runs []span, parallelism int, less func(a, b T) bool) []mergeTask {
+	pairs := (len(runs) + 1) / 2
+	pieces := parallelism / pairs
+	if pieces < 1 {
+		pieces = 1
+	}
+
+	tasks := make([]mergeTask, 0, pairs*pieces)
+	for i := 0; i < len(runs); i += 2 {
+		a := runs[i]
+		b := span{lo: a.hi, hi: a.hi}
+		if i+1 < len(runs) {
+			b = runs[i+1]
+		}
+		total := b.hi - a.lo
+		n := pieces
+		if limit := total / parallelSortThreshold; n > limit {
+			n = limit
+		}
+		if n < 1 {
+			n = 1
+		}
+
+		prevA, prevB := a.lo, b.lo
+		for k := 1; k <= n; k++ {
+			t := total * k / n
+			fromA := coRank(t, src[a.lo:a.hi], src[b.lo:b.hi], less)
+			nextA, nextB := a.lo+fromA, b.lo+t-fromA
+			tasks = append(tasks, mergeTask{
+				a:   span{lo: prevA, hi: nextA},
+				b:   span{lo: prevB, hi: nextB},
+				out: a.lo + total*(k-1)/n,
+			})
+			prevA, prevB = nextA, nextB
+		}
+	}
+	return tasks
+}
+
+// coRank returns the number of elements of a among the first t elements of the
+// merge of a and b, where elements of a come before equal elements of b.
+func coRank[T any](t int, a, b []T, less func(a, b T) bool) int {
+	lo, hi := t-len(b), t
+	if lo < 0 {
+		lo = 0
+	}
+	if hi > len(a) {
+		hi = len(a)
+	}
+	for lo < hi {
+		i := int(uint(lo+hi) >> 1)
+		j := t - i
+		if j > 0 && !less(b[j-1], a[i]) {
+			lo = i + 1
+		} else {
+			hi = i
+		}
+	}
+	return lo
+}
+
+// mergeSpans merges the spans of the task from src into dst.
+func mergeSpans[T any](m mergeTask, src, dst []T, less func(a, b T) bool) {
+	a, b := src[m.a.lo:m.a.hi], src[m.b.lo:m.b.hi]
+	out := dst[m.out : m.out+len(a)+len(b)]
+	i, j, k := 0, 0, 0
+	for i < len(a) && j < len(b) {
+		if less(b[j], a[i]) {
+			out[k] = b[j]
+			j++
+		} else {
+			out[k] = a[i]
+			i++
+		}
+		k++
+	}
+	k += copy(out[k:], a[i:])
+	copy(out[k:], b[j:])
+}
+
+// lessSlice implements sort.Interface for a slice and a less function.
+type lessSlice[T any] struct {
+	items []T
+	less  func(a, b T) bool
+}
+
+func (s lessSlice[T]) Len() int {
+	return len(s.items)
+}
+
+func (s lessSlice[T]) Less(i, j int) bool {
+	return s.less(s.items[i], s.items[j])
+}
+
+func (s lessSlice[T]) Swap(i, j int) {
+	s.items[i], s.items[j] = s.items[j], s.items[i]
+}
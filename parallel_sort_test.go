@@ -0,0 +1,119 @@
+package slices
+
+import (
+	"fmt"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParallelSort(t *testing.T) {
+	src := NewRandomizer(42)
+	random := func(n int) []int {
+		res := make([]int, n)
+		for i := range res {
+			res[i] = src.Intn(n / 4)
+		}
+		return res
+	}
+	less := func(a, b int) bool {
+		return a < b
+	}
+
+	tests := []struct {
+		name        string
+		in          []int
+		parallelism int
+	}{
+		{
+			name:        "Small Slice",
+			in:          random(100),
+			parallelism: 4,
+		},
+		{
+			name:        "Parallelism of 1",
+			in:          random(20000),
+			parallelism: 1,
+		},
+		{
+			name:        "Uneven Runs",
+			in:          random(100003),
+			parallelism: 3,
+		},
+		{
+			name:        "Many Workers",
+			in:          random(200000),
+			parallelism: 16,
+		},
+		{
+			name:        "Already Sorted",
+			in:          generateDataSet(50000),
+			parallelism: 4,
+		},
+		{
+			name:        "Empty",
+			in:          []int{},
+			parallelism: 4,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			expected := Clone(test.in)
+			sort.Ints(expected)
+			ParallelSort(test.in, less, test.parallelism)
+			assert.Equal(t, expected, test.in)
+		})
+	}
+}
+
+func TestParallelSort_Panic(t *testing.T) {
+	in := generateDataSet(100000)
+	Reverse(in)
+	assert.Panics(t, func() {
+		ParallelSort(in, func(a, b int) bool {
+			if a == 500 {
+				panic("kaboom")
+			}
+			return a < b
+		}, 4)
+	})
+	assert.Panics(t, func() {
+		ParallelSort(in, func(a, b int) bool { return a < b }, 0)
+	})
+}
+
+func TestParallelSort_PanicSmallSlice(t *testing.T) {
+	for _, parallelism := range []int{1, 4} {
+		t.Run(fmt.Sprintf("parallelism %d", parallelism), func(t *testing.T) {
+			defer func() {
+				pe, ok := recover().(*PanicError)
+				if assert.True(t, ok) {
+					assert.Equal(t, "kaboom", pe.Value)
+					assert.NotEmpty(t, pe.Stack)
+				}
+			}()
+
+			ParallelSort([]int{3, 2, 1}, func(a, b int) bool {
+				panic("kaboom")
+			}, parallelism)
+		})
+	}
+}
+
+func TestCoRank(t *testing.T) {
+	less := func(a, b int) bool {
+		return a < b
+	}
+	a := []int{1, 3, 3, 5, 7}
+	b := []int{2, 3, 4, 8}
+	merged := []int{1, 2, 3, 3, 3, 4, 5, 7, 8}
+
+	for k := 0; k <= len(merged); k++ {
+		i := coRank(k, a, b, less)
+		taken := append(Clone(a[:i]), b[:k-i]...)
+		sort.Ints(taken)
+		assert.Equal(t, merged[:k], taken, "k=%d", k)
+	}
+}
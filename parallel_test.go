@@ -0,0 +1,416 @@
+package slices
+
+import (
+	"context"
+	"errors"
+	"runtime"
+	"strconv"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestForEachParallelCtx(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	tests := []struct {
+		name        string
+		in          []int
+		fn          func(context.Context, int) error
+		opts        []ParallelOption
+		parallelism int
+		wantIndexes []int
+	}{
+		{
+			name: "No Errors",
+			in:   generateDataSet(1000),
+			fn: func(ctx context.Context, i int) error {
+				return nil
+			},
+			parallelism: 4,
+			wantIndexes: nil,
+		},
+		{
+			name: "Fail Fast Returns First Error",
+			in:   []int{1, 2, 3, 4, 5},
+			fn: func(ctx context.Context, i int) error {
+				if i == 3 {
+					return errBoom
+				}
+				return nil
+			},
+			parallelism: 1,
+			wantIndexes: []int{2},
+		},
+		{
+			name: "Collect All Errors",
+			in:   generateDataSet(100),
+			fn: func(ctx context.Context, i int) error {
+				if i%25 == 0 {
+					return errBoom
+				}
+				return nil
+			},
+			opts:        []ParallelOption{WithErrorMode(CollectAll)},
+			parallelism: 4,
+			wantIndexes: []int{0, 25, 50, 75},
+		},
+		{
+			name: "Empty Slice",
+			in:   []int{},
+			fn: func(ctx context.Context, i int) error {
+				return errBoom
+			},
+			parallelism: 4,
+			wantIndexes: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := ForEachParallelCtx(context.Background(), test.in, test.fn, test.parallelism, test.opts...)
+			if test.wantIndexes == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, errBoom)
+
+			var indexes []int
+			var errs []error
+			if joined, ok := err.(interface{ Unwrap() []error }); ok {
+				errs = joined.Unwrap()
+			} else {
+				errs = []error{err}
+			}
+			for _, e := range errs {
+				var elemErr *ElementError
+				if assert.ErrorAs(t, e, &elemErr) {
+					indexes = append(indexes, elemErr.Index)
+				}
+			}
+			assert.Equal(t, test.wantIndexes, indexes)
+		})
+	}
+}
+
+func TestForEachParallelCtx_FailFastStopsProcessing(t *testing.T) {
+	var processed int64
+	in := generateDataSet(100000)
+
+	err := ForEachParallelCtx(context.Background(), in, func(ctx context.Context, i int) error {
+		atomic.AddInt64(&processed, 1)
+		if i == 10 {
+			return errors.New("stop")
+		}
+		return nil
+	}, runtime.GOMAXPROCS(0))
+
+	assert.Error(t, err)
+	assert.Less(t, atomic.LoadInt64(&processed), int64(len(in)))
+}
+
+func TestForEachParallelCtx_Cancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	var processed int64
+	in := generateDataSet(100000)
+
+	err := ForEachParallelCtx(ctx, in, func(ctx context.Context, i int) error {
+		if atomic.AddInt64(&processed, 1) == 100 {
+			cancel()
+		}
+		return nil
+	}, 4)
+
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Less(t, atomic.LoadInt64(&processed), int64(len(in)))
+}
+
+func TestParallel_CancelledStopsImmediately(t *testing.T) {
+	tests := []struct {
+		name string
+		opts []ParallelOption
+	}{
+		{
+			name: "Default",
+		},
+		{
+			name: "Large Batches",
+			opts: []ParallelOption{WithBatchSize(500)},
+		},
+		{
+			name: "Work Stealing",
+			opts: []ParallelOption{WithScheduler(WorkStealing)},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			in := generateDataSet(1000)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			calls := 0
+			err := ForEachParallelCtx(ctx, in, func(ctx context.Context, i int) error {
+				calls++
+				if calls == 2 {
+					cancel()
+				}
+				return nil
+			}, 1, test.opts...)
+			assert.ErrorIs(t, err, context.Canceled)
+			assert.Equal(t, 2, calls)
+
+			ctx, cancel = context.WithCancel(context.Background())
+			calls = 0
+			res, err := MapParallelErr(ctx, in, func(ctx context.Context, i int) (int, error) {
+				calls++
+				if calls == 2 {
+					cancel()
+				}
+				return i, nil
+			}, 1, test.opts...)
+			assert.ErrorIs(t, err, context.Canceled)
+			assert.Nil(t, res)
+			assert.Equal(t, 2, calls)
+		})
+	}
+}
+
+func TestMapParallel(t *testing.T) {
+	tests := []struct {
+		name        string
+		in          []int
+		mapper      func(int) string
+		parallelism int
+		opts        []ParallelOption
+		expected    []string
+	}{
+		{
+			name:        "Preserves Order",
+			in:          []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10},
+			mapper:      strconv.Itoa,
+			parallelism: 3,
+			expected:    []string{"1", "2", "3", "4", "5", "6", "7", "8", "9", "10"},
+		},
+		{
+			name:        "Batch Size Larger Than Slice",
+			in:          []int{1, 2, 3},
+			mapper:      strconv.Itoa,
+			parallelism: 8,
+			opts:        []ParallelOption{WithBatchSize(100)},
+			expected:    []string{"1", "2", "3"},
+		},
+		{
+			name:        "Nil Slice",
+			in:          nil,
+			mapper:      strconv.Itoa,
+			parallelism: 4,
+			expected:    []string{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual := MapParallel(test.in, test.mapper, test.parallelism, test.opts...)
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestMapParallel_LargeSlice(t *testing.T) {
+	in := generateDataSet(100000)
+	actual := MapParallel(in, func(i int) int {
+		return i * 2
+	}, runtime.GOMAXPROCS(0))
+	assert.Equal(t, Map(in, func(i int) int {
+		return i * 2
+	}), actual)
+}
+
+func TestMapParallelErr(t *testing.T) {
+	errOdd := errors.New("odd")
+
+	tests := []struct {
+		name     string
+		in       []int
+		mapper   func(context.Context, int) (int, error)
+		opts     []ParallelOption
+		expected []int
+		err      error
+	}{
+		{
+			name: "Success",
+			in:   []int{1, 2, 3, 4},
+			mapper: func(ctx context.Context, i int) (int, error) {
+				return i * i, nil
+			},
+			expected: []int{1, 4, 9, 16},
+		},
+		{
+			name: "Error Discards Results",
+			in:   []int{2, 4, 5, 6},
+			mapper: func(ctx context.Context, i int) (int, error) {
+				if i%2 != 0 {
+					return 0, errOdd
+				}
+				return i, nil
+			},
+			opts:     []ParallelOption{WithErrorMode(CollectAll)},
+			expected: nil,
+			err:      errOdd,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual, err := MapParallelErr(context.Background(), test.in, test.mapper, 2, test.opts...)
+			assert.ErrorIs(t, err, test.err)
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestForEachParallel_Panic(t *testing.T) {
+	var processed int64
+	in := generateDataSet(100000)
+
+	defer func() {
+		v := recover()
+		pe, ok := v.(*PanicError)
+		if !assert.True(t, ok, "expected *PanicError, got %T", v) {
+			return
+		}
+		assert.Equal(t, 50, pe.Index)
+		assert.Equal(t, "kaboom", pe.Value)
+		assert.Contains(t, string(pe.Stack), "TestForEachParallel_Panic")
+		assert.Less(t, atomic.LoadInt64(&processed), int64(len(in)))
+	}()
+
+	ForEachParallel(in, func(i int) {
+		atomic.AddInt64(&processed, 1)
+		if i == 50 {
+			panic("kaboom")
+		}
+	}, 4)
+	t.Error("expected ForEachParallel to panic")
+}
+
+func TestForEachParallelCtx_PanicAsError(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	err := ForEachParallelCtx(context.Background(), []int{1, 2, 3}, func(ctx context.Context, i int) error {
+		if i == 2 {
+			panic(errBoom)
+		}
+		return nil
+	}, 2, WithPanicAsError())
+
+	var pe *PanicError
+	assert.ErrorAs(t, err, &pe)
+	assert.Equal(t, 1, pe.Index)
+	assert.ErrorIs(t, err, errBoom)
+}
+
+func TestMapParallel_PanicAsErrorIgnored(t *testing.T) {
+	assert.Panics(t, func() {
+		MapParallel([]int{1, 2, 3}, func(i int) int {
+			panic("kaboom")
+		}, 2, WithPanicAsError())
+	})
+}
+
+func TestFilterParallel(t *testing.T) {
+	tests := []struct {
+		name        string
+		in          []int
+		pred        Predicate[int]
+		parallelism int
+		expected    []int
+	}{
+		{
+			name: "Even Numbers",
+			in:   []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10},
+			pred: func(i int) bool {
+				return i%2 == 0
+			},
+			parallelism: 3,
+			expected:    []int{2, 4, 6, 8, 10},
+		},
+		{
+			name: "No Matches",
+			in:   []int{1, 3, 5},
+			pred: func(i int) bool {
+				return i%2 == 0
+			},
+			parallelism: 2,
+			expected:    []int{},
+		},
+		{
+			name: "Nil Slice",
+			in:   nil,
+			pred: func(i int) bool {
+				return true
+			},
+			parallelism: 2,
+			expected:    []int{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual := FilterParallel(test.in, test.pred, test.parallelism)
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestReduceParallel(t *testing.T) {
+	add := func(a, b int) int {
+		return a + b
+	}
+
+	tests := []struct {
+		name        string
+		in          []int
+		parallelism int
+		opts        []ParallelOption
+		expected    int
+	}{
+		{
+			name:        "Sum",
+			in:          generateDataSet(10000),
+			parallelism: 4,
+			expected:    49995000,
+		},
+		{
+			name:        "Sum With Custom Batch Size",
+			in:          generateDataSet(100),
+			parallelism: 4,
+			opts:        []ParallelOption{WithBatchSize(7)},
+			expected:    4950,
+		},
+		{
+			name:        "Empty Slice",
+			in:          []int{},
+			parallelism: 4,
+			expected:    0,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual := ReduceParallel(test.in, add, add, 0, test.parallelism, test.opts...)
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestReduceParallel_PreservesOrder(t *testing.T) {
+	in := []string{"a", "b", "c", "d", "e", "f", "g", "h", "i", "j"}
+	concat := func(a, b string) string {
+		return a + b
+	}
+	actual := ReduceParallel(in, concat, concat, "", 3)
+	assert.Equal(t, "abcdefghij", actual)
+}
@@ -0,0 +1,130 @@
+package slices
+
+import (
+	"math"
+	"unsafe"
+)
+
+// Integer is a constraint that permits any integer type.
+type Integer interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+// Float is a constraint that permits any floating-point type.
+type Float interface {
+	~float32 | ~float64
+}
+
+// RadixSort sorts a slice of integers in place in ascending order using a least
+// significant digit radix sort. It runs in linear time, which makes it much
+// faster than a comparison sort for large slices, at the cost of buffers
+// proportional to the size of the slice.
+func RadixSort[T Integer](in []T) {
+	SortByKey(in, func(item T) T {
+		return item
+	})
+}
+
+// RadixSortFloats sorts a slice of floating-point numbers in place in ascending
+// order using a least significant digit radix sort, see RadixSort. NaNs are
+// placed before all other values, matching the order of the sort package.
+func RadixSortFloats[T Float](in []T) {
+	if len(in) < 2 {
+		return
+	}
+	var zero T
+	width := int(unsafe.Sizeof(zero))
+	keys := make([]uint64, len(in))
+	for i, v := range in {
+		keys[i] = floatKey(v, width)
+	}
+	radixSort(in, keys, width)
+}
+
+// SortByKey sorts the slice in place in ascending order of the integer key
+// generated from the key function using a least significant digit radix sort,
+// see RadixSort. The key function is called once per element, and elements
+// with equal keys keep their original order.
+func SortByKey[T any, K Integer](in []T, keyFn func(item T) K) {
+	if len(in) < 2 {
+		return
+	}
+	var zero K
+	width := int(unsafe.Sizeof(zero))
+	keys := make([]uint64, len(in))
+	for i := range in {
+		keys[i] = integerKey(keyFn(in[i]), width)
+	}
+	radixSort(in, keys, width)
+}
+
+// integerKey maps an integer to an unsigned key of the given width in bytes
+// with the same order, by flipping the sign bit of signed integers.
+func integerKey[K Integer](v K, width int) uint64 {
+	var zero K
+	key := uint64(v)
+	if width < 8 {
+		key &= 1<<(width*8) - 1
+	}
+	if zero-1 < zero {
+		key ^= 1 << (width*8 - 1)
+	}
+	return key
+}
+
+// floatKey maps a floating-point number to an unsigned key of the given width
+// in bytes with the same order. The sign bit of positive numbers is set and all
+// the bits of negative numbers are flipped, so the keys of negative numbers
+// sort in reverse. NaNs map to zero, which sorts first.
+func floatKey[T Float](v T, width int) uint64 {
+	if v != v {
+		return 0
+	}
+	var bits, sign uint64
+	if width == 4 {
+		bits, sign = uint64(math.Float32bits(float32(v))), 1<<31
+	} else {
+		bits, sign = math.Float64bits(float64(v)), 1<<63
+	}
+	if bits&sign != 0 {
+		return ^bits & (sign<<1 - 1)
+	}
+	return bits | sign
+}
+
+// radixSort sorts the slice by its keys one byte at a time starting from the
+// least significant byte, width is the number of bytes of the keys. Each pass
+// is a stable counting sort, passes where every key has the same byte are
+// skipped.
+func radixSort[T any](in []T, keys []uint64, width int) {
+	n := len(in)
+	srcItems, srcKeys := in, keys
+	dstItems, dstKeys := make([]T, n), make([]uint64, n)
+
+	for shift := 0; shift < width*8; shift += 8 {
+		var offsets [256]int
+		for _, k := range srcKeys {
+			offsets[byte(k>>shift)]++
+		}
+		if offsets[byte(srcKeys[0]>>shift)] == n {
+			continue
+		}
+		pos := 0
+		for i, count := range offsets {
+			offsets[i] = pos
+			pos += count
+		}
+		for i, k := range srcKeys {
+			d := byte(k >> shift)
+			dstItems[offsets[d]] = srcItems[i]
+			dstKeys[offsets[d]] = k
+			offsets[d]++
+		}
+		srcItems, dstItems = dstItems, srcItems
+		srcKeys, dstKeys = dstKeys, srcKeys
+	}
+	if &srcItems[0] != &in[0] {
+		copy(in, srcItems)
+	}
+}
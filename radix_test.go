@@ -0,0 +1,64 @@
+package slices
+
+import (
+	"math"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRadixSort(t *testing.T) {
+	src := NewRandomizer(42)
+
+	ints := make([]int64, 10000)
+	for i := range ints {
+		ints[i] = int64(src.Intn(math.MaxInt32)) - math.MaxInt32/2
+	}
+	ints = append(ints, math.MinInt64, math.MaxInt64, 0, -1)
+	expected := Clone(ints)
+	sort.Slice(expected, func(i, j int) bool { return expected[i] < expected[j] })
+	RadixSort(ints)
+	assert.Equal(t, expected, ints)
+
+	int8s := []int8{5, -128, 127, -1, 0, 1, -5}
+	RadixSort(int8s)
+	assert.Equal(t, []int8{-128, -5, -1, 0, 1, 5, 127}, int8s)
+
+	uints := []uint16{500, 65535, 0, 1, 256}
+	RadixSort(uints)
+	assert.Equal(t, []uint16{0, 1, 256, 500, 65535}, uints)
+
+	empty := []int{}
+	RadixSort(empty)
+	assert.Equal(t, []int{}, empty)
+}
+
+func TestRadixSortFloats(t *testing.T) {
+	in := []float64{3.5, -2, math.Inf(1), 0, math.NaN(), -0.5, math.Inf(-1), 1e-300, -1e300}
+	RadixSortFloats(in)
+	assert.True(t, math.IsNaN(in[0]))
+	assert.Equal(t, []float64{math.Inf(-1), -1e300, -2, -0.5, 0, 1e-300, 3.5, math.Inf(1)}, in[1:])
+
+	in32 := []float32{1.5, -1.5, 0.25, -100, 100}
+	RadixSortFloats(in32)
+	assert.Equal(t, []float32{-100, -1.5, 0.25, 1.5, 100}, in32)
+}
+
+func TestSortByKey(t *testing.T) {
+	in := []person{
+		{"Jane", "Smith", 3},
+		{"John", "Doe", -1},
+		{"Adam", "Smith", 3},
+		{"Jane", "Doe", 1},
+	}
+	SortByKey(in, func(p person) int {
+		return p.ID
+	})
+	assert.Equal(t, []person{
+		{"John", "Doe", -1},
+		{"Jane", "Doe", 1},
+		{"Jane", "Smith", 3},
+		{"Adam", "Smith", 3},
+	}, in)
+}
@@ -0,0 +1,105 @@
+package slices
+
+import (
+	cryptorand "crypto/rand"
+	"encoding/binary"
+	"math/big"
+	"math/rand"
+	"sync"
+)
+
+// Randomizer is a source of random numbers used by the functions that operate
+// on slices randomly, such as ShuffleWith.
+//
+// A *rand.Rand from math/rand satisfies Randomizer, but unlike the Randomizers
+// provided by this package it isn't safe for concurrent use.
+type Randomizer interface {
+	// Intn returns a uniformly distributed random number in [0, n). It panics
+	// if n <= 0.
+	Intn(n int) int
+
+	// Float64 returns a uniformly distributed random number in [0.0, 1.0).
+	Float64() float64
+}
+
+var defaultRandomizer Randomizer = globalRandomizer{}
+
+// DefaultRandomizer returns the Randomizer used by functions such as Shuffle
+// that don't accept a Randomizer. It's safe for concurrent use and seeded
+// randomly, so its results can't be reproduced.
+func DefaultRandomizer() Randomizer {
+	return defaultRandomizer
+}
+
+// NewRandomizer creates a Randomizer seeded with the given seed, which always
+// produces the same sequence of random numbers for the same seed. This is
+// useful for reproducible results in tests.
+//
+// The Randomizer is safe for concurrent use, but the results are only
+// reproducible if it's called in the same order.
+func NewRandomizer(seed int64) Randomizer {
+	return &lockedRandomizer{
+		r: rand.New(rand.NewSource(seed)),
+	}
+}
+
+// NewCryptoRandomizer creates a Randomizer backed by the cryptographically
+// secure random number generator from crypto/rand, for uses where the results
+// must not be predictable, such as ordering a draw. It's considerably slower
+// than the other Randomizers and is safe for concurrent use.
+func NewCryptoRandomizer() Randomizer {
+	return cryptoRandomizer{}
+}
+
+// globalRandomizer uses the top-level functions of math/rand, which are safe
+// for concurrent use.
+type globalRandomizer struct{}
+
+func (globalRandomizer) Intn(n int) int {
+	return rand.Intn(n)
+}
+
+func (globalRandomizer) Float64() float64 {
+	return rand.Float64()
+}
+
+// lockedRandomizer makes a *rand.Rand safe for concurrent use.
+type lockedRandomizer struct {
+	mu sync.Mutex
+	r  *rand.Rand
+}
+
+func (l *lockedRandomizer) Intn(n int) int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.r.Intn(n)
+}
+
+func (l *lockedRandomizer) Float64() float64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.r.Float64()
+}
+
+type cryptoRandomizer struct{}
+
+func (cryptoRandomizer) Intn(n int) int {
+	if n <= 0 {
+		panic("invalid argument to Intn")
+	}
+	v, err := cryptorand.Int(cryptorand.Reader, big.NewInt(int64(n)))
+	if err != nil {
+		panic(err)
+	}
+	return int(v.Int64())
+}
+
+func (cryptoRandomizer) Float64() float64 {
+	var b [8]byte
+	if _, err := cryptorand.Read(b[:]); err != nil {
+		panic(err)
+	}
+	// Use the top 53 bits, the precision of a float64, so every value is
+	// equally likely.
+	return float64(binary.BigEndian.Uint64(b[:])>>11) / (1 << 53)
+}
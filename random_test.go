@@ -0,0 +1,85 @@
+package slices
+
+import (
+	"math/rand"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShuffleWith(t *testing.T) {
+	tests := []struct {
+		name string
+		src  func() Randomizer
+	}{
+		{
+			name: "Default Randomizer",
+			src:  DefaultRandomizer,
+		},
+		{
+			name: "Seeded Randomizer",
+			src: func() Randomizer {
+				return NewRandomizer(42)
+			},
+		},
+		{
+			name: "Crypto Randomizer",
+			src:  NewCryptoRandomizer,
+		},
+		{
+			name: "Math Rand",
+			src: func() Randomizer {
+				return rand.New(rand.NewSource(42))
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			in := generateDataSet(100)
+			ShuffleWith(test.src(), in)
+			assert.NotEqual(t, generateDataSet(100), in)
+
+			sort.Ints(in)
+			assert.Equal(t, generateDataSet(100), in)
+		})
+	}
+}
+
+func TestShuffleWith_Reproducible(t *testing.T) {
+	first := generateDataSet(50)
+	second := generateDataSet(50)
+	ShuffleWith(NewRandomizer(7), first)
+	ShuffleWith(NewRandomizer(7), second)
+	assert.Equal(t, first, second)
+}
+
+func TestShuffle_Concurrent(t *testing.T) {
+	wg := sync.WaitGroup{}
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			Shuffle(generateDataSet(100))
+		}()
+	}
+	wg.Wait()
+}
+
+func TestCryptoRandomizer(t *testing.T) {
+	src := NewCryptoRandomizer()
+	for i := 0; i < 1000; i++ {
+		n := src.Intn(10)
+		assert.GreaterOrEqual(t, n, 0)
+		assert.Less(t, n, 10)
+
+		f := src.Float64()
+		assert.GreaterOrEqual(t, f, 0.0)
+		assert.Less(t, f, 1.0)
+	}
+	assert.Panics(t, func() {
+		src.Intn(0)
+	})
+}
@@ -0,0 +1,77 @@
+package slices
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// WithRateLimit limits how often the function passed to a parallel function is
+// called to perSecond calls per second, allowing bursts of up to burst calls.
+// The limit applies to each call of a parallel function individually and every
+// attempt made by WithRetry counts towards it. A perSecond value of 0 or less
+// disables rate limiting, and a burst less than 1 is treated as 1.
+//
+// ParallelSort doesn't support rate limiting and panics if it's used.
+func WithRateLimit(perSecond float64, burst int) ParallelOption {
+	return func(cfg *parallelConfig) {
+		cfg.rateLimit = perSecond
+		cfg.rateBurst = burst
+	}
+}
+
+// tokenBucket is a token bucket rate limiter. Callers reserve a token up front,
+// letting the number of tokens go negative, and then wait for the time it takes
+// for the bucket to refill up to their reservation. This keeps waiting callers
+// in the order they arrived without having them compete for tokens.
+type tokenBucket struct {
+	mu     sync.Mutex
+	clock  Clock
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func newTokenBucket(clock Clock, perSecond float64, burst int) *tokenBucket {
+	if burst < 1 {
+		burst = 1
+	}
+	return &tokenBucket{
+		clock:  clock,
+		rate:   perSecond,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   clock.Now(),
+	}
+}
+
+// wait blocks until a token is available or ctx is cancelled.
+func (b *tokenBucket) wait(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	return sleep(ctx, b.clock, b.reserve())
+}
+
+// reserve takes a token from the bucket and returns how long the caller has to
+// wait before using it.
+func (b *tokenBucket) reserve() time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := b.clock.Now()
+	if elapsed := now.Sub(b.last); elapsed > 0 {
+		b.tokens += elapsed.Seconds() * b.rate
+		if b.tokens > b.burst {
+			b.tokens = b.burst
+		}
+		b.last = now
+	}
+
+	b.tokens--
+	if b.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-b.tokens / b.rate * float64(time.Second))
+}
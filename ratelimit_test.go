@@ -0,0 +1,159 @@
+package slices
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithRateLimit(t *testing.T) {
+	clock := newFakeClock()
+	start := clock.Now()
+
+	var mu sync.Mutex
+	var calls []time.Duration
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- ForEachParallelCtx(context.Background(), generateDataSet(5), func(ctx context.Context, i int) error {
+			mu.Lock()
+			defer mu.Unlock()
+			calls = append(calls, clock.Now().Sub(start))
+			return nil
+		}, 1, WithRateLimit(10, 2), WithClock(clock))
+	}()
+
+	// The burst allows two calls right away, the rest are spaced 100ms apart
+	for i := 0; i < 3; i++ {
+		clock.WaitForTimers(1)
+		clock.Advance(100 * time.Millisecond)
+	}
+
+	assert.NoError(t, <-errCh)
+	assert.Equal(t, []time.Duration{
+		0,
+		0,
+		100 * time.Millisecond,
+		200 * time.Millisecond,
+		300 * time.Millisecond,
+	}, calls)
+}
+
+func TestWithRateLimit_PlainVariants(t *testing.T) {
+	tests := []struct {
+		name string
+		call func(fn func(int), opts ...ParallelOption)
+	}{
+		{
+			name: "ForEachParallel",
+			call: func(fn func(int), opts ...ParallelOption) {
+				ForEachParallel(generateDataSet(4), fn, 1, opts...)
+			},
+		},
+		{
+			name: "MapParallel",
+			call: func(fn func(int), opts ...ParallelOption) {
+				MapParallel(generateDataSet(4), func(i int) int {
+					fn(i)
+					return i
+				}, 1, opts...)
+			},
+		},
+		{
+			name: "FilterParallel",
+			call: func(fn func(int), opts ...ParallelOption) {
+				FilterParallel(generateDataSet(4), func(i int) bool {
+					fn(i)
+					return true
+				}, 1, opts...)
+			},
+		},
+		{
+			name: "ReduceParallel",
+			call: func(fn func(int), opts ...ParallelOption) {
+				add := func(a, b int) int {
+					return a + b
+				}
+				ReduceParallel(generateDataSet(4), func(agg, i int) int {
+					fn(i)
+					return agg + i
+				}, add, 0, 1, opts...)
+			},
+		},
+		{
+			name: "ForEachParallelByKey",
+			call: func(fn func(int), opts ...ParallelOption) {
+				ForEachParallelByKey(generateDataSet(4), isEven, fn, 1, opts...)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			clock := newFakeClock()
+			start := clock.Now()
+
+			var mu sync.Mutex
+			var calls []time.Duration
+			done := make(chan struct{})
+			go func() {
+				defer close(done)
+				test.call(func(int) {
+					mu.Lock()
+					defer mu.Unlock()
+					calls = append(calls, clock.Now().Sub(start))
+				}, WithRateLimit(10, 1), WithClock(clock))
+			}()
+
+			for i := 0; i < 3; i++ {
+				clock.WaitForTimers(1)
+				clock.Advance(100 * time.Millisecond)
+			}
+
+			<-done
+			assert.Equal(t, []time.Duration{
+				0,
+				100 * time.Millisecond,
+				200 * time.Millisecond,
+				300 * time.Millisecond,
+			}, calls)
+		})
+	}
+}
+
+func TestWithRateLimit_ParallelSortRejected(t *testing.T) {
+	assert.Panics(t, func() {
+		ParallelSort([]int{2, 1}, func(a, b int) bool {
+			return a < b
+		}, 2, WithRateLimit(10, 1))
+	})
+}
+
+func TestTokenBucket(t *testing.T) {
+	clock := newFakeClock()
+	b := newTokenBucket(clock, 2, 1)
+
+	assert.Equal(t, time.Duration(0), b.reserve())
+	assert.Equal(t, 500*time.Millisecond, b.reserve())
+	assert.Equal(t, time.Second, b.reserve())
+
+	// Refilling never exceeds the burst
+	clock.Advance(10 * time.Second)
+	assert.Equal(t, time.Duration(0), b.reserve())
+	assert.Equal(t, 500*time.Millisecond, b.reserve())
+}
+
+func TestTokenBucket_Cancelled(t *testing.T) {
+	clock := newFakeClock()
+	b := newTokenBucket(clock, 1, 1)
+	assert.NoError(t, b.wait(context.Background()))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		clock.WaitForTimers(1)
+		cancel()
+	}()
+	assert.ErrorIs(t, b.wait(ctx), context.Canceled)
+}
@@ -0,0 +1,180 @@
+package slices
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+// RetryPolicy determines how the error returning parallel functions retry an
+// element when the function processing it returns an error, see WithRetry.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of times an element is attempted,
+	// including the first attempt. Values less than 2 disable retrying.
+	MaxAttempts int
+
+	// InitialBackoff is how long to wait before the first retry.
+	InitialBackoff time.Duration
+
+	// MaxBackoff caps how long to wait between attempts. A value of 0 or less
+	// doesn't cap the backoff.
+	MaxBackoff time.Duration
+
+	// Multiplier is the factor the backoff grows by after each retry. Values
+	// less than 1 are treated as 2.
+	Multiplier float64
+
+	// Jitter randomizes each backoff by up to the given fraction of it in
+	// either direction, so elements that failed together don't retry together.
+	// It's clamped to the range [0, 1].
+	Jitter float64
+
+	// Retryable reports whether an attempt that failed with the given error
+	// should be retried. If nil every error is retried.
+	Retryable func(err error) bool
+}
+
+// WithRetry retries elements that fail according to the RetryPolicy. Elements
+// are never retried once the Context of the parallel function has been
+// cancelled, and if all attempts fail the error of the last attempt is
+// returned. It's only supported by the parallel functions that return an
+// error, the others panic if it's used.
+func WithRetry(policy RetryPolicy) ParallelOption {
+	return func(cfg *parallelConfig) {
+		cfg.retry = &policy
+	}
+}
+
+// WithTimeout limits how long each call of the function passed to a parallel
+// function may take, after which the Context passed to it is cancelled with
+// context.DeadlineExceeded. Every attempt made by WithRetry gets its own
+// timeout. It's only supported by the parallel functions that return an error,
+// the others panic if it's used. A timeout of 0 or less disables it.
+func WithTimeout(timeout time.Duration) ParallelOption {
+	return func(cfg *parallelConfig) {
+		cfg.timeout = timeout
+	}
+}
+
+// backoff returns how long to wait before the given retry, starting at 1.
+func (p *RetryPolicy) backoff(retry int) time.Duration {
+	multiplier := p.Multiplier
+	if multiplier < 1 {
+		multiplier = 2
+	}
+	backoff := float64(p.InitialBackoff) * math.Pow(multiplier, float64(retry-1))
+	if p.MaxBackoff > 0 && backoff > float64(p.MaxBackoff) {
+		backoff = float64(p.MaxBackoff)
+	}
+
+	jitter := math.Max(0, math.Min(1, p.Jitter))
+	if jitter > 0 {
+		backoff += backoff * jitter * (2*defaultRandomizer.Float64() - 1)
+	}
+	return time.Duration(backoff)
+}
+
+func (p *RetryPolicy) retryable(err error) bool {
+	return p.Retryable == nil || p.Retryable(err)
+}
+
+// invoke calls attempt applying the rate limit, retry policy and timeout
+// configured by the options.
+func (cfg *parallelConfig) invoke(ctx context.Context, attempt func(context.Context) error) error {
+	if cfg.limiter == nil && cfg.retry == nil && cfg.timeout <= 0 {
+		return attempt(ctx)
+	}
+
+	for n := 1; ; n++ {
+		if cfg.limiter != nil {
+			if err := cfg.limiter.wait(ctx); err != nil {
+				return err
+			}
+		}
+
+		err := cfg.attempt(ctx, attempt)
+		if err == nil || ctx.Err() != nil || cfg.retry == nil ||
+			n >= cfg.retry.MaxAttempts || !cfg.retry.retryable(err) {
+			return err
+		}
+
+		if sleep(ctx, cfg.clock, cfg.retry.backoff(n)) != nil {
+			return err
+		}
+	}
+}
+
+// attempt calls attempt once, applying the timeout if one is configured.
+func (cfg *parallelConfig) attempt(ctx context.Context, attempt func(context.Context) error) error {
+	if cfg.timeout <= 0 {
+		return attempt(ctx)
+	}
+	ctx, cancel := withClockTimeout(ctx, cfg.clock, cfg.timeout)
+	defer cancel()
+	return attempt(ctx)
+}
+
+// clockTimeoutContext is a Context that is cancelled with
+// context.DeadlineExceeded once a timer on a Clock expires, the equivalent of
+// context.WithTimeout for an arbitrary Clock.
+type clockTimeoutContext struct {
+	context.Context
+	deadline time.Time
+	done     chan struct{}
+
+	mu  sync.Mutex
+	err error
+}
+
+func withClockTimeout(parent context.Context, clock Clock, timeout time.Duration) (context.Context, context.CancelFunc) {
+	ctx := &clockTimeoutContext{
+		Context:  parent,
+		deadline: clock.Now().Add(timeout),
+		done:     make(chan struct{}),
+	}
+	if parentDeadline, ok := parent.Deadline(); ok && parentDeadline.Before(ctx.deadline) {
+		ctx.deadline = parentDeadline
+	}
+
+	stop := clock.AfterFunc(timeout, func() {
+		ctx.cancel(context.DeadlineExceeded)
+	})
+	if parentDone := parent.Done(); parentDone != nil {
+		go func() {
+			select {
+			case <-parentDone:
+				ctx.cancel(parent.Err())
+			case <-ctx.done:
+			}
+		}()
+	}
+	return ctx, func() {
+		stop()
+		ctx.cancel(context.Canceled)
+	}
+}
+
+func (c *clockTimeoutContext) cancel(err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.err != nil {
+		return
+	}
+	c.err = err
+	close(c.done)
+}
+
+func (c *clockTimeoutContext) Deadline() (time.Time, bool) {
+	return c.deadline, true
+}
+
+func (c *clockTimeoutContext) Done() <-chan struct{} {
+	return c.done
+}
+
+func (c *clockTimeoutContext) Err() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.err
+}
@@ -0,0 +1,168 @@
+package slices
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithRetry(t *testing.T) {
+	errTemporary := errors.New("temporary")
+	errPermanent := errors.New("permanent")
+
+	tests := []struct {
+		name         string
+		policy       RetryPolicy
+		failures     int
+		failWith     error
+		backoffs     []time.Duration
+		wantAttempts int64
+		wantErr      error
+	}{
+		{
+			name: "Succeeds After Retries",
+			policy: RetryPolicy{
+				MaxAttempts:    3,
+				InitialBackoff: time.Second,
+				Multiplier:     2,
+			},
+			failures:     2,
+			failWith:     errTemporary,
+			backoffs:     []time.Duration{time.Second, 2 * time.Second},
+			wantAttempts: 3,
+		},
+		{
+			name: "Attempts Exhausted",
+			policy: RetryPolicy{
+				MaxAttempts:    2,
+				InitialBackoff: time.Second,
+			},
+			failures:     5,
+			failWith:     errTemporary,
+			backoffs:     []time.Duration{time.Second},
+			wantAttempts: 2,
+			wantErr:      errTemporary,
+		},
+		{
+			name: "Error Not Retryable",
+			policy: RetryPolicy{
+				MaxAttempts:    3,
+				InitialBackoff: time.Second,
+				Retryable: func(err error) bool {
+					return !errors.Is(err, errPermanent)
+				},
+			},
+			failures:     5,
+			failWith:     errPermanent,
+			wantAttempts: 1,
+			wantErr:      errPermanent,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			clock := newFakeClock()
+
+			var attempts int64
+			errCh := make(chan error, 1)
+			go func() {
+				errCh <- ForEachParallelCtx(context.Background(), []int{1}, func(ctx context.Context, i int) error {
+					if atomic.AddInt64(&attempts, 1) <= int64(test.failures) {
+						return test.failWith
+					}
+					return nil
+				}, 1, WithRetry(test.policy), WithClock(clock))
+			}()
+
+			for _, backoff := range test.backoffs {
+				clock.WaitForTimers(1)
+				clock.Advance(backoff)
+			}
+
+			err := <-errCh
+			assert.ErrorIs(t, err, test.wantErr)
+			if test.wantErr == nil {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, test.wantAttempts, atomic.LoadInt64(&attempts))
+		})
+	}
+}
+
+func TestWithRetry_PlainVariantsRejected(t *testing.T) {
+	opts := []ParallelOption{
+		WithRetry(RetryPolicy{MaxAttempts: 3}),
+		WithTimeout(time.Second),
+	}
+	for _, opt := range opts {
+		assert.Panics(t, func() {
+			ForEachParallel([]int{1}, func(int) {}, 1, opt)
+		})
+		assert.Panics(t, func() {
+			MapParallel([]int{1}, func(i int) int { return i }, 1, opt)
+		})
+		assert.Panics(t, func() {
+			FilterParallel([]int{1}, isEven, 1, opt)
+		})
+		assert.Panics(t, func() {
+			ForEachParallelByKey([]int{1}, isEven, func(int) {}, 1, opt)
+		})
+	}
+}
+
+func TestRetryPolicy_Backoff(t *testing.T) {
+	policy := RetryPolicy{
+		InitialBackoff: 100 * time.Millisecond,
+		MaxBackoff:     time.Second,
+		Multiplier:     3,
+	}
+	assert.Equal(t, 100*time.Millisecond, policy.backoff(1))
+	assert.Equal(t, 300*time.Millisecond, policy.backoff(2))
+	assert.Equal(t, 900*time.Millisecond, policy.backoff(3))
+	assert.Equal(t, time.Second, policy.backoff(4))
+
+	policy.Jitter = 0.5
+	for i := 0; i < 100; i++ {
+		backoff := policy.backoff(1)
+		assert.GreaterOrEqual(t, backoff, 50*time.Millisecond)
+		assert.LessOrEqual(t, backoff, 150*time.Millisecond)
+	}
+}
+
+func TestWithTimeout(t *testing.T) {
+	clock := newFakeClock()
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- ForEachParallelCtx(context.Background(), []int{1}, func(ctx context.Context, i int) error {
+			deadline, ok := ctx.Deadline()
+			assert.True(t, ok)
+			assert.Equal(t, clock.Now().Add(5*time.Second), deadline)
+			<-ctx.Done()
+			return ctx.Err()
+		}, 1, WithTimeout(5*time.Second), WithClock(clock))
+	}()
+
+	clock.WaitForTimers(1)
+	clock.Advance(5 * time.Second)
+
+	err := <-errCh
+	var elemErr *ElementError
+	assert.ErrorAs(t, err, &elemErr)
+	assert.Equal(t, 0, elemErr.Index)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestWithClockTimeout_ParentCancelled(t *testing.T) {
+	parent, cancel := context.WithCancel(context.Background())
+	ctx, stop := withClockTimeout(parent, newFakeClock(), time.Minute)
+	defer stop()
+
+	cancel()
+	<-ctx.Done()
+	assert.ErrorIs(t, ctx.Err(), context.Canceled)
+}
@@ -0,0 +1,174 @@
+package slices
+
+import (
+	"fmt"
+	"math"
+)
+
+// Sample returns a random element of the slice and true, or the zero value and
+// false if the slice is empty.
+func Sample[T any](in []T) (T, bool) {
+	return SampleWith(defaultRandomizer, in)
+}
+
+// SampleWith is like Sample but uses the Randomizer as the source of
+// randomness.
+func SampleWith[T any](src Randomizer, in []T) (res T, ok bool) {
+	if len(in) == 0 {
+		return res, false
+	}
+	return in[src.Intn(len(in))], true
+}
+
+// SampleN returns a new slice containing n elements picked at random from the
+// slice without replacement, in random order. An element is never picked more
+// than once, but the slice may contain duplicate values. If n is greater than
+// the length of the slice all the elements are returned in random order. The
+// slice itself is left unchanged.
+//
+// Providing an n less than 0 will result in a panic.
+func SampleN[T any](in []T, n int) []T {
+	return SampleNWith(defaultRandomizer, in, n)
+}
+
+// SampleNWith is like SampleN but uses the Randomizer as the source of
+// randomness.
+func SampleNWith[T any](src Randomizer, in []T, n int) []T {
+	if n < 0 {
+		panic(fmt.Errorf("illegal sample size %d, cannot be less than 0", n))
+	}
+	if n > len(in) {
+		n = len(in)
+	}
+
+	// When sampling most of the slice shuffling a copy is cheapest
+	if n > len(in)/4 {
+		res := Clone(in)
+		partialShuffle(src, res, n)
+		return res[:n:n]
+	}
+
+	// Otherwise the partial Fisher-Yates shuffle only records the positions
+	// it swapped, so it takes time and memory proportional to n rather than
+	// the length of the slice.
+	swapped := make(map[int]int, n)
+	at := func(i int) int {
+		if j, ok := swapped[i]; ok {
+			return j
+		}
+		return i
+	}
+	res := make([]T, n)
+	for i := 0; i < n; i++ {
+		j := i + src.Intn(len(in)-i)
+		res[i] = in[at(j)]
+		swapped[j] = at(i)
+	}
+	return res
+}
+
+// partialShuffle randomly moves n elements of the slice to its front, the first
+// n steps of a Fisher-Yates shuffle.
+func partialShuffle[T any](src Randomizer, s []T, n int) {
+	for i := 0; i < n && i < len(s)-1; i++ {
+		j := i + src.Intn(len(s)-i)
+		s[i], s[j] = s[j], s[i]
+	}
+}
+
+// SampleWeighted returns a random element of the slice and true, where the
+// chance of each element being picked is proportional to the weight returned
+// by the weight function. Elements whose weight is 0, negative, infinite or NaN
+// are never picked. If the slice is empty or no element has a positive weight
+// the zero value and false are returned.
+func SampleWeighted[T any](in []T, weight func(item T) float64) (T, bool) {
+	return SampleWeightedWith(defaultRandomizer, in, weight)
+}
+
+// SampleWeightedWith is like SampleWeighted but uses the Randomizer as the
+// source of randomness.
+func SampleWeightedWith[T any](src Randomizer, in []T, weight func(item T) float64) (res T, ok bool) {
+	weights := make([]float64, len(in))
+	total := 0.0
+	for i, item := range in {
+		w := weight(item)
+		if w > 0 && !math.IsInf(w, 1) {
+			weights[i] = w
+			total += w
+		}
+	}
+	if total == 0 {
+		return res, false
+	}
+
+	target := src.Float64() * total
+	last := -1
+	for i, w := range weights {
+		if w == 0 {
+			continue
+		}
+		last = i
+		if target < w {
+			return in[i], true
+		}
+		target -= w
+	}
+	// Floating point rounding can leave target marginally above the sum of the
+	// weights, in which case the last element with a weight is the pick.
+	return in[last], true
+}
+
+// Reservoir samples a fixed number of elements uniformly at random from a
+// stream of elements whose length isn't known up front, using reservoir
+// sampling. Every element added has the same chance of being in the sample,
+// while only the sample is kept in memory.
+//
+// A Reservoir isn't safe for concurrent use.
+type Reservoir[T any] struct {
+	src   Randomizer
+	items []T
+	size  int
+	seen  int
+}
+
+// NewReservoir creates a Reservoir that keeps a sample of up to size elements
+// using the Randomizer as the source of randomness. A nil Randomizer uses the
+// DefaultRandomizer.
+//
+// Providing a size less than 1 will result in a panic.
+func NewReservoir[T any](size int, src Randomizer) *Reservoir[T] {
+	if size < 1 {
+		panic(fmt.Errorf("illegal size, cannot create a reservoir whose size is less than 1"))
+	}
+	if src == nil {
+		src = defaultRandomizer
+	}
+	return &Reservoir[T]{
+		src:   src,
+		items: make([]T, 0, size),
+		size:  size,
+	}
+}
+
+// Add offers an element from the stream to the Reservoir.
+func (r *Reservoir[T]) Add(item T) {
+	r.seen++
+	if len(r.items) < r.size {
+		r.items = append(r.items, item)
+		return
+	}
+	if j := r.src.Intn(r.seen); j < r.size {
+		r.items[j] = item
+	}
+}
+
+// Items returns a new slice containing the current sample. If fewer elements
+// than the size of the Reservoir have been added, all of them are returned.
+func (r *Reservoir[T]) Items() []T {
+	return Clone(r.items)
+}
+
+// Seen returns the number of elements added to the Reservoir.
+func (r *Reservoir[T]) Seen() int {
+	return r.seen
+}
@@ -0,0 +1,169 @@
+package slices
+
+import (
+	"math"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSampleWith(t *testing.T) {
+	in := []string{"a", "b", "c"}
+	for i := 0; i < 100; i++ {
+		item, ok := SampleWith(NewRandomizer(int64(i)), in)
+		assert.True(t, ok)
+		assert.Contains(t, in, item)
+	}
+
+	_, ok := Sample([]string{})
+	assert.False(t, ok)
+}
+
+func TestSampleNWith(t *testing.T) {
+	tests := []struct {
+		name     string
+		in       []int
+		n        int
+		expected int
+	}{
+		{
+			name:     "Few Elements of Large Slice",
+			in:       generateDataSet(1000),
+			n:        10,
+			expected: 10,
+		},
+		{
+			name:     "Most Elements",
+			in:       generateDataSet(100),
+			n:        90,
+			expected: 90,
+		},
+		{
+			name:     "More Than Length",
+			in:       generateDataSet(5),
+			n:        10,
+			expected: 5,
+		},
+		{
+			name:     "Zero",
+			in:       generateDataSet(5),
+			n:        0,
+			expected: 0,
+		},
+		{
+			name:     "Nil Slice",
+			in:       nil,
+			n:        3,
+			expected: 0,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			original := Clone(test.in)
+			actual := SampleNWith(NewRandomizer(1), test.in, test.n)
+			assert.Len(t, actual, test.expected)
+			assert.Equal(t, original, test.in)
+
+			// Every element is picked at most once
+			assert.Len(t, Unique(actual), test.expected)
+			for _, item := range actual {
+				assert.Contains(t, test.in, item)
+			}
+
+			// The same seed picks the same elements
+			assert.Equal(t, actual, SampleNWith(NewRandomizer(1), test.in, test.n))
+		})
+	}
+
+	assert.Panics(t, func() {
+		SampleN([]int{1}, -1)
+	})
+}
+
+func TestSampleN_Uniform(t *testing.T) {
+	src := NewRandomizer(3)
+	counts := make([]int, 20)
+	for i := 0; i < 20000; i++ {
+		for _, item := range SampleNWith(src, generateDataSet(20), 2) {
+			counts[item]++
+		}
+	}
+	// Each element is expected to be picked 2000 times
+	for i, c := range counts {
+		assert.InDelta(t, 2000, c, 200, "element %d", i)
+	}
+}
+
+func TestSampleWeightedWith(t *testing.T) {
+	type prize struct {
+		name   string
+		weight float64
+	}
+	in := []prize{
+		{name: "common", weight: 8},
+		{name: "rare", weight: 2},
+		{name: "never", weight: 0},
+		{name: "invalid", weight: math.NaN()},
+		{name: "negative", weight: -5},
+	}
+	weight := func(p prize) float64 {
+		return p.weight
+	}
+
+	src := NewRandomizer(11)
+	counts := make(map[string]int)
+	for i := 0; i < 10000; i++ {
+		p, ok := SampleWeightedWith(src, in, weight)
+		assert.True(t, ok)
+		counts[p.name]++
+	}
+	assert.Len(t, counts, 2)
+	assert.InDelta(t, 8000, counts["common"], 300)
+	assert.InDelta(t, 2000, counts["rare"], 300)
+
+	_, ok := SampleWeighted(in[2:], weight)
+	assert.False(t, ok)
+}
+
+func TestReservoir(t *testing.T) {
+	r := NewReservoir[int](5, NewRandomizer(5))
+	for i := 0; i < 3; i++ {
+		r.Add(i)
+	}
+	assert.Equal(t, []int{0, 1, 2}, r.Items())
+
+	for i := 3; i < 1000; i++ {
+		r.Add(i)
+	}
+	items := r.Items()
+	assert.Len(t, items, 5)
+	assert.Len(t, Unique(items), 5)
+	assert.Equal(t, 1000, r.Seen())
+
+	sort.Ints(items)
+	assert.NotEqual(t, []int{0, 1, 2, 3, 4}, items)
+
+	assert.Panics(t, func() {
+		NewReservoir[int](0, nil)
+	})
+}
+
+func TestReservoir_Uniform(t *testing.T) {
+	src := NewRandomizer(9)
+	counts := make([]int, 10)
+	for i := 0; i < 10000; i++ {
+		r := NewReservoir[int](3, src)
+		for j := 0; j < 10; j++ {
+			r.Add(j)
+		}
+		for _, item := range r.Items() {
+			counts[item]++
+		}
+	}
+	// Each element is expected to be in the sample 3000 times
+	for i, c := range counts {
+		assert.InDelta(t, 3000, c, 250, "element %d", i)
+	}
+}
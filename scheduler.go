@@ -0,0 +1,176 @@
+package slices
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// Scheduler determines how the parallel functions distribute the elements of a
+// slice across their workers.
+type Scheduler int
+
+const (
+	// SharedQueue hands out batches of consecutive elements from a queue shared
+	// by all the workers, see WithBatchSize. It balances the load well when the
+	// work done per element is expensive or varies a lot.
+	SharedQueue Scheduler = iota
+
+	// WorkStealing splits the slice into one contiguous range per worker up
+	// front, much like Chunk, and each worker processes its own range without
+	// coordinating with the others. A worker that runs out of work steals the
+	// second half of the remaining range of another worker, so uneven workloads
+	// are still spread across all the workers. It comes close to the throughput
+	// of a plain loop split across cores when the work done per element is
+	// cheap.
+	WorkStealing
+)
+
+// WithScheduler sets the Scheduler used to distribute the elements across the
+// workers. The default is SharedQueue.
+func WithScheduler(s Scheduler) ParallelOption {
+	return func(cfg *parallelConfig) {
+		cfg.scheduler = s
+	}
+}
+
+// scheduler hands out the spans of indexes processed by the workers.
+type scheduler interface {
+	// next returns the next span the given worker should process, or false
+	// once there is no work left.
+	next(worker int) (span, bool)
+
+	// pending returns the number of indexes not yet handed to a worker.
+	pending() int
+}
+
+func newScheduler(cfg *parallelConfig, n, parallelism int) scheduler {
+	if cfg.scheduler == WorkStealing {
+		return newStealingScheduler(n, parallelism, cfg.batchSizeFor(n, parallelism))
+	}
+	return &queueScheduler{
+		n:     n,
+		batch: cfg.batchSizeFor(n, parallelism),
+	}
+}
+
+// queueScheduler implements SharedQueue with a cursor the workers claim the
+// next batch of indexes from.
+type queueScheduler struct {
+	n      int
+	batch  int
+	cursor int64
+}
+
+func (s *queueScheduler) next(_ int) (span, bool) {
+	lo := int(atomic.AddInt64(&s.cursor, int64(s.batch))) - s.batch
+	if lo >= s.n {
+		return span{}, false
+	}
+	hi := lo + s.batch
+	if hi > s.n {
+		hi = s.n
+	}
+	return span{lo: lo, hi: hi}, true
+}
+
+func (s *queueScheduler) pending() int {
+	if p := s.n - int(atomic.LoadInt64(&s.cursor)); p > 0 {
+		return p
+	}
+	return 0
+}
+
+// stealingScheduler implements WorkStealing. Each worker owns a range it takes
+// batches from the front of, while other workers steal from the back of it.
+type stealingScheduler struct {
+	ranges []stealableRange
+	batch  int
+}
+
+type stealableRange struct {
+	mu     sync.Mutex
+	lo, hi int
+
+	// Pads the struct to its own cache line so workers taking from their own
+	// ranges don't contend with each other.
+	_ [64]byte
+}
+
+func newStealingScheduler(n, parallelism, batch int) *stealingScheduler {
+	s := &stealingScheduler{
+		ranges: make([]stealableRange, parallelism),
+		batch:  batch,
+	}
+	size, rem := n/parallelism, n%parallelism
+	lo := 0
+	for i := range s.ranges {
+		hi := lo + size
+		if i < rem {
+			hi++
+		}
+		s.ranges[i].lo, s.ranges[i].hi = lo, hi
+		lo = hi
+	}
+	return s
+}
+
+func (s *stealingScheduler) next(worker int) (span, bool) {
+	own := &s.ranges[worker]
+	if sp, ok := own.take(s.batch); ok {
+		return sp, true
+	}
+
+	for i := 1; i < len(s.ranges); i++ {
+		victim := &s.ranges[(worker+i)%len(s.ranges)]
+		stolen, ok := victim.steal()
+		if !ok {
+			continue
+		}
+		own.mu.Lock()
+		own.lo, own.hi = stolen.lo, stolen.hi
+		own.mu.Unlock()
+		if sp, ok := own.take(s.batch); ok {
+			return sp, true
+		}
+	}
+	return span{}, false
+}
+
+func (s *stealingScheduler) pending() int {
+	pending := 0
+	for i := range s.ranges {
+		r := &s.ranges[i]
+		r.mu.Lock()
+		pending += r.hi - r.lo
+		r.mu.Unlock()
+	}
+	return pending
+}
+
+// take removes up to batch indexes from the front of the range.
+func (r *stealableRange) take(batch int) (span, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.lo >= r.hi {
+		return span{}, false
+	}
+	sp := span{lo: r.lo, hi: r.lo + batch}
+	if sp.hi > r.hi {
+		sp.hi = r.hi
+	}
+	r.lo = sp.hi
+	return sp, true
+}
+
+// steal removes the second half of the remaining indexes from the range.
+func (r *stealableRange) steal() (span, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.lo >= r.hi {
+		return span{}, false
+	}
+	mid := r.lo + (r.hi-r.lo)/2
+	sp := span{lo: mid, hi: r.hi}
+	r.hi = mid
+	return sp, true
+}
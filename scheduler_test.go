@@ -0,0 +1,124 @@
+package slices
+
+import (
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWorkStealing(t *testing.T) {
+	tests := []struct {
+		name        string
+		n           int
+		parallelism int
+		opts        []ParallelOption
+	}{
+		{
+			name:        "Even Split",
+			n:           100000,
+			parallelism: 4,
+		},
+		{
+			name:        "Uneven Split",
+			n:           100003,
+			parallelism: 7,
+		},
+		{
+			name:        "Fewer Elements Than Workers",
+			n:           3,
+			parallelism: 8,
+		},
+		{
+			name:        "Custom Batch Size",
+			n:           1000,
+			parallelism: 4,
+			opts:        []ParallelOption{WithBatchSize(1)},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			counts := make([]int32, test.n)
+			opts := append([]ParallelOption{WithScheduler(WorkStealing)}, test.opts...)
+			ForEachParallel(generateDataSet(test.n), func(i int) {
+				atomic.AddInt32(&counts[i], 1)
+			}, test.parallelism, opts...)
+
+			for i, c := range counts {
+				if c != 1 {
+					t.Fatalf("element %d processed %d times", i, c)
+				}
+			}
+		})
+	}
+}
+
+func TestWorkStealing_UnevenWorkload(t *testing.T) {
+	// All the expensive elements are at the start of the slice, so they all land
+	// in the range of worker 0. While it's stuck on its first element the other
+	// workers drain their own ranges and then have to steal the rest of it.
+	s := newStealingScheduler(64, 4, 1)
+
+	owner := make(map[int]int)
+	sp, ok := s.next(0)
+	assert.True(t, ok)
+	owner[sp.lo] = 0
+
+	for {
+		progressed := false
+		for w := 1; w < 4; w++ {
+			sp, ok := s.next(w)
+			if !ok {
+				continue
+			}
+			progressed = true
+			for i := sp.lo; i < sp.hi; i++ {
+				_, seen := owner[i]
+				assert.False(t, seen, "index %d handed out twice", i)
+				owner[i] = w
+			}
+		}
+		if !progressed {
+			break
+		}
+	}
+
+	assert.Len(t, owner, 64)
+	stolen := 0
+	for i := 0; i < 16; i++ {
+		if owner[i] != 0 {
+			stolen++
+		}
+	}
+	assert.Equal(t, 15, stolen)
+}
+
+func TestStealingScheduler(t *testing.T) {
+	s := newStealingScheduler(10, 2, 2)
+
+	// Worker 1 drains its own range of [5, 10)
+	for _, want := range []span{{5, 7}, {7, 9}, {9, 10}} {
+		sp, ok := s.next(1)
+		assert.True(t, ok)
+		assert.Equal(t, want, sp)
+	}
+
+	// Then steals the second half of the range of worker 0
+	sp, ok := s.next(1)
+	assert.True(t, ok)
+	assert.Equal(t, span{2, 4}, sp)
+
+	sp, ok = s.next(0)
+	assert.True(t, ok)
+	assert.Equal(t, span{0, 2}, sp)
+
+	sp, ok = s.next(1)
+	assert.True(t, ok)
+	assert.Equal(t, span{4, 5}, sp)
+
+	_, ok = s.next(0)
+	assert.False(t, ok)
+	_, ok = s.next(1)
+	assert.False(t, ok)
+}
@@ -0,0 +1,92 @@
+package slices
+
+import (
+	"cmp"
+	"sort"
+)
+
+// BinarySearch searches for target in a slice sorted in ascending order and
+// returns the position where target is found, or the position where it would
+// be inserted to keep the slice sorted, and a boolean indicating if found. If
+// the slice contains several elements equal to target the position of the
+// first one is returned. Unlike Index it runs in O(log n) time.
+func BinarySearch[T cmp.Ordered](in []T, target T) (int, bool) {
+	return BinarySearchFunc(in, target, cmp.Compare[T])
+}
+
+// BinarySearchBy searches for the target key in a slice sorted in ascending
+// order of the key generated from the key function, see BinarySearch.
+func BinarySearchBy[T any, K cmp.Ordered](in []T, target K, keyFn func(item T) K) (int, bool) {
+	i := sort.Search(len(in), func(i int) bool {
+		return cmp.Compare(keyFn(in[i]), target) >= 0
+	})
+	return i, i < len(in) && cmp.Compare(keyFn(in[i]), target) == 0
+}
+
+// BinarySearchFunc searches for target in a slice sorted in the order
+// determined by the Comparator, see BinarySearch.
+func BinarySearchFunc[T any](in []T, target T, c Comparator[T]) (int, bool) {
+	i := LowerBoundFunc(in, target, c)
+	return i, i < len(in) && c(in[i], target) == 0
+}
+
+// LowerBound returns the position of the first element of a slice sorted in
+// ascending order that isn't less than target, or the length of the slice if
+// there is none.
+func LowerBound[T cmp.Ordered](in []T, target T) int {
+	return LowerBoundFunc(in, target, cmp.Compare[T])
+}
+
+// UpperBound returns the position of the first element of a slice sorted in
+// ascending order that is greater than target, or the length of the slice if
+// there is none.
+func UpperBound[T cmp.Ordered](in []T, target T) int {
+	return UpperBoundFunc(in, target, cmp.Compare[T])
+}
+
+// EqualRange returns the bounds of the range of elements equal to target in a
+// slice sorted in ascending order, such that in[lo:hi] holds all of them. If
+// there are none lo and hi are both the position where target would be
+// inserted.
+func EqualRange[T cmp.Ordered](in []T, target T) (lo, hi int) {
+	return EqualRangeFunc(in, target, cmp.Compare[T])
+}
+
+// LowerBoundFunc returns the position of the first element of a slice sorted
+// in the order determined by the Comparator that isn't less than target, see
+// LowerBound.
+func LowerBoundFunc[T any](in []T, target T, c Comparator[T]) int {
+	return sort.Search(len(in), func(i int) bool {
+		return c(in[i], target) >= 0
+	})
+}
+
+// UpperBoundFunc returns the position of the first element of a slice sorted
+// in the order determined by the Comparator that is greater than target, see
+// UpperBound.
+func UpperBoundFunc[T any](in []T, target T, c Comparator[T]) int {
+	return sort.Search(len(in), func(i int) bool {
+		return c(in[i], target) > 0
+	})
+}
+
+// EqualRangeFunc returns the bounds of the range of elements equal to target in
+// a slice sorted in the order determined by the Comparator, see EqualRange.
+func EqualRangeFunc[T any](in []T, target T, c Comparator[T]) (lo, hi int) {
+	lo = LowerBoundFunc(in, target, c)
+	hi = lo + UpperBoundFunc(in[lo:], target, c)
+	return lo, hi
+}
+
+// InsertSorted inserts item into a slice sorted in ascending order, keeping it
+// sorted, and returns the modified slice the same way Insert does. The item is
+// inserted after any elements equal to it.
+func InsertSorted[T cmp.Ordered](in []T, item T) []T {
+	return InsertSortedFunc(in, item, cmp.Compare[T])
+}
+
+// InsertSortedFunc inserts item into a slice sorted in the order determined by
+// the Comparator, see InsertSorted.
+func InsertSortedFunc[T any](in []T, item T, c Comparator[T]) []T {
+	return Insert(in, UpperBoundFunc(in, item, c), item)
+}
@@ -0,0 +1,117 @@
+package slices
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBinarySearch(t *testing.T) {
+	in := []int{1, 3, 3, 3, 5, 8}
+
+	tests := []struct {
+		name   string
+		target int
+		index  int
+		found  bool
+		lower  int
+		upper  int
+	}{
+		{
+			name:   "Found Single",
+			target: 5,
+			index:  4,
+			found:  true,
+			lower:  4,
+			upper:  5,
+		},
+		{
+			name:   "Found Duplicates",
+			target: 3,
+			index:  1,
+			found:  true,
+			lower:  1,
+			upper:  4,
+		},
+		{
+			name:   "Not Found",
+			target: 4,
+			index:  4,
+			lower:  4,
+			upper:  4,
+		},
+		{
+			name:   "Before First",
+			target: 0,
+			index:  0,
+			lower:  0,
+			upper:  0,
+		},
+		{
+			name:   "After Last",
+			target: 9,
+			index:  6,
+			lower:  6,
+			upper:  6,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			index, found := BinarySearch(in, test.target)
+			assert.Equal(t, test.index, index)
+			assert.Equal(t, test.found, found)
+			assert.Equal(t, test.lower, LowerBound(in, test.target))
+			assert.Equal(t, test.upper, UpperBound(in, test.target))
+
+			lo, hi := EqualRange(in, test.target)
+			assert.Equal(t, test.lower, lo)
+			assert.Equal(t, test.upper, hi)
+		})
+	}
+
+	index, found := BinarySearch([]int{}, 1)
+	assert.Equal(t, 0, index)
+	assert.False(t, found)
+}
+
+func TestBinarySearchBy(t *testing.T) {
+	in := []person{
+		{"John", "Doe", 2},
+		{"Jane", "Smith", 5},
+		{"Adam", "Smith", 9},
+	}
+	byID := func(p person) int {
+		return p.ID
+	}
+
+	index, found := BinarySearchBy(in, 5, byID)
+	assert.True(t, found)
+	assert.Equal(t, 1, index)
+
+	index, found = BinarySearchBy(in, 6, byID)
+	assert.False(t, found)
+	assert.Equal(t, 2, index)
+
+	byLastName := ComparingBy(func(p person) string { return p.LastName })
+	lo, hi := EqualRangeFunc(in, person{LastName: "Smith"}, byLastName)
+	assert.Equal(t, 1, lo)
+	assert.Equal(t, 3, hi)
+
+	index, found = BinarySearchFunc(in, person{LastName: "Brown"}, byLastName)
+	assert.False(t, found)
+	assert.Equal(t, 0, index)
+}
+
+func TestInsertSorted(t *testing.T) {
+	var in []int
+	for _, v := range []int{5, 1, 4, 1, 9, 0} {
+		in = InsertSorted(in, v)
+	}
+	assert.Equal(t, []int{0, 1, 1, 4, 5, 9}, in)
+
+	byLastName := ComparingBy(func(p person) string { return p.LastName })
+	people := []person{{"John", "Doe", 1}, {"Jane", "Smith", 2}}
+	people = InsertSortedFunc(people, person{"Jim", "Doe", 3}, byLastName)
+	assert.Equal(t, []int{1, 3, 2}, Map(people, func(p person) int { return p.ID }))
+}
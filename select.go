@@ -0,0 +1,316 @@
+package slices
+
+import (
+	"cmp"
+	"fmt"
+	"math"
+	"math/bits"
+)
+
+// MinFunc returns the least element of the slice in the order determined by the
+// Comparator and a boolean indicating if the slice wasn't empty. If several
+// elements are equally least the first of them is returned.
+func MinFunc[T any](in []T, c Comparator[T]) (res T, ok bool) {
+	if len(in) == 0 {
+		return res, false
+	}
+	res = in[0]
+	for i := 1; i < len(in); i++ {
+		if c(in[i], res) < 0 {
+			res = in[i]
+		}
+	}
+	return res, true
+}
+
+// MaxFunc returns the greatest element of the slice in the order determined by
+// the Comparator and a boolean indicating if the slice wasn't empty. If several
+// elements are equally greatest the first of them is returned.
+func MaxFunc[T any](in []T, c Comparator[T]) (T, bool) {
+	return MinFunc(in, c.Reversed())
+}
+
+// MinBy returns the element of the slice with the least key, as generated by
+// the key function, and a boolean indicating if the slice wasn't empty. If
+// several elements have the least key the first of them is returned. The key
+// function is called once per element.
+func MinBy[T any, K cmp.Ordered](in []T, keyFn func(item T) K) (T, bool) {
+	return extremeBy(in, keyFn, -1)
+}
+
+// MaxBy returns the element of the slice with the greatest key, as generated by
+// the key function, and a boolean indicating if the slice wasn't empty. If
+// several elements have the greatest key the first of them is returned. The key
+// function is called once per element.
+func MaxBy[T any, K cmp.Ordered](in []T, keyFn func(item T) K) (T, bool) {
+	return extremeBy(in, keyFn, 1)
+}
+
+func extremeBy[T any, K cmp.Ordered](in []T, keyFn func(item T) K, sign int) (res T, ok bool) {
+	if len(in) == 0 {
+		return res, false
+	}
+	res, best := in[0], keyFn(in[0])
+	for i := 1; i < len(in); i++ {
+		if key := keyFn(in[i]); cmp.Compare(key, best)*sign > 0 {
+			res, best = in[i], key
+		}
+	}
+	return res, true
+}
+
+// MinMax returns the least and greatest elements of the slice in a single pass
+// and a boolean indicating if the slice wasn't empty.
+func MinMax[T cmp.Ordered](in []T) (least, greatest T, ok bool) {
+	return MinMaxFunc(in, cmp.Compare[T])
+}
+
+// MinMaxFunc returns the least and greatest elements of the slice in the order
+// determined by the Comparator in a single pass and a boolean indicating if the
+// slice wasn't empty. Ties are resolved the same way as MinFunc and MaxFunc.
+func MinMaxFunc[T any](in []T, c Comparator[T]) (least, greatest T, ok bool) {
+	if len(in) == 0 {
+		return least, greatest, false
+	}
+	least, greatest = in[0], in[0]
+	for i := 1; i < len(in); i++ {
+		if c(in[i], least) < 0 {
+			least = in[i]
+		}
+		if c(in[i], greatest) > 0 {
+			greatest = in[i]
+		}
+	}
+	return least, greatest, true
+}
+
+// MinMaxBy returns the elements of the slice with the least and greatest keys,
+// as generated by the key function, in a single pass and a boolean indicating
+// if the slice wasn't empty. Ties are resolved the same way as MinBy and MaxBy.
+// The key function is called once per element.
+func MinMaxBy[T any, K cmp.Ordered](in []T, keyFn func(item T) K) (least, greatest T, ok bool) {
+	if len(in) == 0 {
+		return least, greatest, false
+	}
+	least, greatest = in[0], in[0]
+	leastKey := keyFn(in[0])
+	greatestKey := leastKey
+	for i := 1; i < len(in); i++ {
+		key := keyFn(in[i])
+		if cmp.Less(key, leastKey) {
+			least, leastKey = in[i], key
+		}
+		if cmp.Less(greatestKey, key) {
+			greatest, greatestKey = in[i], key
+		}
+	}
+	return least, greatest, true
+}
+
+// TopK returns the k greatest elements of the slice in the order determined by
+// the Comparator, from greatest to least. Equal elements are returned in the
+// order they occur in the slice, and when they don't all fit the earliest are
+// kept. It runs in O(n log k) time keeping at most k elements in a heap, rather
+// than sorting the whole slice. If k is greater than the length of the slice
+// all the elements are returned.
+//
+// Providing a k less than 0 will result in a panic.
+func TopK[T any](in []T, k int, c Comparator[T]) []T {
+	return BottomK(in, k, c.Reversed())
+}
+
+// BottomK returns the k least elements of the slice in the order determined by
+// the Comparator, from least to greatest. Ties are resolved the same way as
+// TopK.
+//
+// Providing a k less than 0 will result in a panic.
+func BottomK[T any](in []T, k int, c Comparator[T]) []T {
+	if k < 0 {
+		panic(fmt.Errorf("illegal k, cannot select less than 0 elements"))
+	}
+	if k > len(in) {
+		k = len(in)
+	}
+	h := boundedHeap[T]{
+		items: make([]indexed[T], 0, k),
+		cmp:   c,
+	}
+	for i := 0; i < len(in) && k > 0; i++ {
+		item := indexed[T]{item: in[i], index: i}
+		if len(h.items) < k {
+			h.push(item)
+		} else if h.before(item, h.items[0]) {
+			h.items[0] = item
+			h.down(0)
+		}
+	}
+
+	res := make([]T, len(h.items))
+	for i := len(res) - 1; i >= 0; i-- {
+		res[i] = h.pop().item
+	}
+	return res
+}
+
+// indexed is an element along with its index in the slice it came from, used to
+// break ties between equal elements.
+type indexed[T any] struct {
+	item  T
+	index int
+}
+
+// boundedHeap is a binary max-heap of the elements selected so far by BottomK,
+// its root is the element that would be evicted first.
+type boundedHeap[T any] struct {
+	items []indexed[T]
+	cmp   Comparator[T]
+}
+
+// before returns true if a is ordered before b, equal elements are ordered by
+// their index.
+func (h *boundedHeap[T]) before(a, b indexed[T]) bool {
+	if res := h.cmp(a.item, b.item); res != 0 {
+		return res < 0
+	}
+	return a.index < b.index
+}
+
+func (h *boundedHeap[T]) push(item indexed[T]) {
+	h.items = append(h.items, item)
+	for i := len(h.items) - 1; i > 0; {
+		parent := (i - 1) / 2
+		if !h.before(h.items[parent], h.items[i]) {
+			break
+		}
+		h.items[parent], h.items[i] = h.items[i], h.items[parent]
+		i = parent
+	}
+}
+
+func (h *boundedHeap[T]) pop() indexed[T] {
+	root := h.items[0]
+	last := len(h.items) - 1
+	h.items[0] = h.items[last]
+	h.items = h.items[:last]
+	h.down(0)
+	return root
+}
+
+func (h *boundedHeap[T]) down(i int) {
+	for {
+		largest := i
+		for _, child := range []int{2*i + 1, 2*i + 2} {
+			if child < len(h.items) && h.before(h.items[largest], h.items[child]) {
+				largest = child
+			}
+		}
+		if largest == i {
+			return
+		}
+		h.items[i], h.items[largest] = h.items[largest], h.items[i]
+		i = largest
+	}
+}
+
+// NthElement rearranges the slice in place so that the element at index n is
+// the element that would be there if the slice was sorted in the order
+// determined by the Comparator. The elements before n are all less than or
+// equal to it and the elements after it are all greater than or equal to it,
+// in no particular order. It runs in O(n) time on average using quickselect,
+// falling back to sorting if the partitions are unbalanced.
+//
+// If n is out of bounds this will panic.
+func NthElement[T any](in []T, n int, c Comparator[T]) {
+	_ = in[n] // bounds check
+	lo, hi := 0, len(in)
+	budget := 2 * bits.Len(uint(len(in)))
+	for hi-lo > 12 {
+		if budget == 0 {
+			SortFunc(in[lo:hi], c)
+			return
+		}
+		budget--
+
+		pivot := medianOfThree(in[lo], in[lo+(hi-lo)/2], in[hi-1], c)
+		lt, gt := partition3(in[lo:hi], pivot, c)
+		lt, gt = lo+lt, lo+gt
+		switch {
+		case n < lt:
+			hi = lt
+		case n >= gt:
+			lo = gt
+		default:
+			return
+		}
+	}
+	for i := lo + 1; i < hi; i++ {
+		for j := i; j > lo && c(in[j], in[j-1]) < 0; j-- {
+			in[j], in[j-1] = in[j-1], in[j]
+		}
+	}
+}
+
+// partition3 rearranges the slice into the elements less than, equal to and
+// greater than the pivot, returning the bounds of the equal elements.
+func partition3[T any](in []T, pivot T, c Comparator[T]) (lt, gt int) {
+	lt, gt = 0, len(in)
+	for i := 0; i < gt; {
+		switch res := c(in[i], pivot); {
+		case res < 0:
+			in[lt], in[i] = in[i], in[lt]
+			lt++
+			i++
+		case res > 0:
+			gt--
+			in[gt], in[i] = in[i], in[gt]
+		default:
+			i++
+		}
+	}
+	return lt, gt
+}
+
+func medianOfThree[T any](a, b, d T, c Comparator[T]) T {
+	if c(b, a) < 0 {
+		a, b = b, a
+	}
+	if c(d, b) < 0 {
+		b = d
+		if c(b, a) < 0 {
+			b = a
+		}
+	}
+	return b
+}
+
+// Median returns the median of the slice and a boolean indicating if the slice
+// wasn't empty. When the slice has an even number of elements the lower of the
+// two middle elements is returned, as the elements can't be averaged in
+// general. It's the same as Percentile with a p of 50. The slice isn't
+// modified.
+func Median[T cmp.Ordered](in []T) (T, bool) {
+	return Percentile(in, 50)
+}
+
+// Percentile returns the p-th percentile of the slice using the nearest-rank
+// method, the least element that is greater than or equal to p percent of the
+// elements, and a boolean indicating if the slice wasn't empty. A p of 0
+// returns the least element and a p of 100 the greatest. It runs in O(n) time
+// using NthElement on a copy of the slice, the slice isn't modified.
+//
+// Providing a p outside the range [0, 100] will result in a panic.
+func Percentile[T cmp.Ordered](in []T, p float64) (res T, ok bool) {
+	if !(p >= 0 && p <= 100) {
+		panic(fmt.Errorf("illegal percentile %v, must be between 0 and 100", p))
+	}
+	if len(in) == 0 {
+		return res, false
+	}
+	rank := int(math.Ceil(p / 100 * float64(len(in))))
+	if rank < 1 {
+		rank = 1
+	}
+	cloned := Clone(in)
+	NthElement(cloned, rank-1, cmp.Compare[T])
+	return cloned[rank-1], true
+}
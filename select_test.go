@@ -0,0 +1,216 @@
+package slices
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMinMax(t *testing.T) {
+	people := []person{
+		{"Jane", "Smith", 3},
+		{"John", "Doe", 1},
+		{"Adam", "Smith", 7},
+		{"Jim", "Doe", 1},
+		{"Eve", "Brown", 7},
+	}
+	byID := func(p person) int {
+		return p.ID
+	}
+
+	min, ok := MinBy(people, byID)
+	assert.True(t, ok)
+	assert.Equal(t, "John", min.FirstName)
+
+	max, ok := MaxBy(people, byID)
+	assert.True(t, ok)
+	assert.Equal(t, "Adam", max.FirstName)
+
+	min, ok = MinFunc(people, ComparingBy(byID))
+	assert.True(t, ok)
+	assert.Equal(t, "John", min.FirstName)
+
+	max, ok = MaxFunc(people, ComparingBy(byID))
+	assert.True(t, ok)
+	assert.Equal(t, "Adam", max.FirstName)
+
+	least, greatest, ok := MinMaxFunc(people, ComparingBy(byID))
+	assert.True(t, ok)
+	assert.Equal(t, "John", least.FirstName)
+	assert.Equal(t, "Adam", greatest.FirstName)
+
+	calls := 0
+	least, greatest, ok = MinMaxBy(people, func(p person) int {
+		calls++
+		return p.ID
+	})
+	assert.True(t, ok)
+	assert.Equal(t, "John", least.FirstName)
+	assert.Equal(t, "Adam", greatest.FirstName)
+	assert.Equal(t, len(people), calls)
+
+	least, greatest, ok = MinMaxBy(people[:1], byID)
+	assert.True(t, ok)
+	assert.Equal(t, "Jane", least.FirstName)
+	assert.Equal(t, "Jane", greatest.FirstName)
+
+	_, _, ok = MinMaxBy([]person{}, byID)
+	assert.False(t, ok)
+
+	lo, hi, ok := MinMax([]int{4, -2, 9, 0})
+	assert.True(t, ok)
+	assert.Equal(t, -2, lo)
+	assert.Equal(t, 9, hi)
+
+	_, ok = MinBy([]person{}, byID)
+	assert.False(t, ok)
+	_, _, ok = MinMax([]int{})
+	assert.False(t, ok)
+}
+
+func TestTopK(t *testing.T) {
+	in := []person{
+		{"a", "", 5},
+		{"b", "", 9},
+		{"c", "", 5},
+		{"d", "", 1},
+		{"e", "", 9},
+		{"f", "", 5},
+	}
+	byID := ComparingBy(func(p person) int { return p.ID })
+	names := func(p []person) []string {
+		return Map(p, func(p person) string { return p.FirstName })
+	}
+
+	tests := []struct {
+		name     string
+		actual   []person
+		expected []string
+	}{
+		{
+			name:     "Top 3",
+			actual:   TopK(in, 3, byID),
+			expected: []string{"b", "e", "a"},
+		},
+		{
+			name:     "Top 4 Ties Keep Earliest",
+			actual:   TopK(in, 4, byID),
+			expected: []string{"b", "e", "a", "c"},
+		},
+		{
+			name:     "Bottom 3",
+			actual:   BottomK(in, 3, byID),
+			expected: []string{"d", "a", "c"},
+		},
+		{
+			name:     "K Greater Than Len",
+			actual:   BottomK(in, 10, byID),
+			expected: []string{"d", "a", "c", "f", "b", "e"},
+		},
+		{
+			name:     "Zero",
+			actual:   TopK(in, 0, byID),
+			expected: []string{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, names(test.actual))
+		})
+	}
+
+	assert.Panics(t, func() {
+		TopK(in, -1, byID)
+	})
+}
+
+func TestTopK_LargeSlice(t *testing.T) {
+	src := NewRandomizer(42)
+	in := make([]int, 10000)
+	for i := range in {
+		in[i] = src.Intn(1000)
+	}
+	sorted := Clone(in)
+	sort.Sort(sort.Reverse(sort.IntSlice(sorted)))
+
+	assert.Equal(t, sorted[:10], TopK(in, 10, Natural[int]()))
+}
+
+func TestNthElement(t *testing.T) {
+	src := NewRandomizer(42)
+	for _, n := range []int{1, 5, 13, 100, 1000} {
+		in := make([]int, n)
+		for i := range in {
+			in[i] = src.Intn(n/2 + 1)
+		}
+		sorted := Clone(in)
+		sort.Ints(sorted)
+
+		for _, k := range []int{0, n / 3, n / 2, n - 1} {
+			actual := Clone(in)
+			NthElement(actual, k, Natural[int]())
+			assert.Equal(t, sorted[k], actual[k])
+			for i := range actual {
+				if i < k {
+					assert.LessOrEqual(t, actual[i], actual[k])
+				} else if i > k {
+					assert.GreaterOrEqual(t, actual[i], actual[k])
+				}
+			}
+			sort.Ints(actual)
+			assert.Equal(t, sorted, actual)
+		}
+	}
+
+	assert.Panics(t, func() {
+		NthElement([]int{1, 2}, 2, Natural[int]())
+	})
+}
+
+func TestPercentile(t *testing.T) {
+	in := []int{15, 20, 35, 40, 50}
+
+	tests := []struct {
+		name     string
+		p        float64
+		expected int
+	}{
+		{name: "Zero", p: 0, expected: 15},
+		{name: "5th", p: 5, expected: 15},
+		{name: "30th", p: 30, expected: 20},
+		{name: "40th", p: 40, expected: 20},
+		{name: "50th", p: 50, expected: 35},
+		{name: "100th", p: 100, expected: 50},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual, ok := Percentile(in, test.p)
+			assert.True(t, ok)
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+
+	assert.Equal(t, []int{15, 20, 35, 40, 50}, in)
+	_, ok := Percentile([]int{}, 50)
+	assert.False(t, ok)
+	assert.Panics(t, func() {
+		Percentile(in, 101)
+	})
+}
+
+func TestMedian(t *testing.T) {
+	median, ok := Median([]int{9, 1, 5})
+	assert.True(t, ok)
+	assert.Equal(t, 5, median)
+
+	median, ok = Median([]int{4, 1, 3, 2})
+	assert.True(t, ok)
+	assert.Equal(t, 2, median)
+
+	word, ok := Median([]string{"pear", "apple", "fig"})
+	assert.True(t, ok)
+	assert.Equal(t, "fig", word)
+}
@@ -0,0 +1,225 @@
+package slices
+
+import (
+	"encoding/json"
+	"iter"
+	"sort"
+)
+
+// Set is an unordered collection of unique elements, offering constant time
+// membership checks unlike calling Contains on a slice. A Set remembers the
+// order elements were first added in, see OrderedSlice.
+//
+// The zero value is an empty Set ready to use. A Set isn't safe for concurrent
+// use.
+type Set[T comparable] struct {
+	items map[T]uint64
+	seq   uint64
+}
+
+// NewSet creates a Set containing the given elements. Duplicate elements are
+// only added once.
+func NewSet[T comparable](items ...T) *Set[T] {
+	s := &Set[T]{items: make(map[T]uint64, len(items))}
+	s.Add(items...)
+	return s
+}
+
+// Add adds the elements to the Set. Elements already in the Set keep their
+// original position in the insertion order.
+func (s *Set[T]) Add(items ...T) {
+	if s.items == nil {
+		s.items = make(map[T]uint64, len(items))
+	}
+	for _, item := range items {
+		if _, ok := s.items[item]; ok {
+			continue
+		}
+		s.items[item] = s.seq
+		s.seq++
+	}
+}
+
+// Remove removes the elements from the Set. Elements not in the Set are
+// ignored.
+func (s *Set[T]) Remove(items ...T) {
+	for _, item := range items {
+		delete(s.items, item)
+	}
+}
+
+// Has returns true if the element is in the Set.
+func (s *Set[T]) Has(item T) bool {
+	_, ok := s.items[item]
+	return ok
+}
+
+// Len returns the number of elements in the Set.
+func (s *Set[T]) Len() int {
+	return len(s.items)
+}
+
+// Union returns a new Set containing the elements that are in either Set.
+func (s *Set[T]) Union(other *Set[T]) *Set[T] {
+	res := NewSet(s.OrderedSlice()...)
+	res.Add(other.OrderedSlice()...)
+	return res
+}
+
+// Intersection returns a new Set containing the elements that are in both Sets.
+func (s *Set[T]) Intersection(other *Set[T]) *Set[T] {
+	res := NewSet[T]()
+	for _, item := range s.OrderedSlice() {
+		if other.Has(item) {
+			res.Add(item)
+		}
+	}
+	return res
+}
+
+// Difference returns a new Set containing the elements that are in this Set but
+// not in the other.
+func (s *Set[T]) Difference(other *Set[T]) *Set[T] {
+	res := NewSet[T]()
+	for _, item := range s.OrderedSlice() {
+		if !other.Has(item) {
+			res.Add(item)
+		}
+	}
+	return res
+}
+
+// SymmetricDifference returns a new Set containing the elements that are in
+// exactly one of the Sets.
+func (s *Set[T]) SymmetricDifference(other *Set[T]) *Set[T] {
+	res := s.Difference(other)
+	for _, item := range other.OrderedSlice() {
+		if !s.Has(item) {
+			res.Add(item)
+		}
+	}
+	return res
+}
+
+// IsSubset returns true if every element of this Set is in the other.
+func (s *Set[T]) IsSubset(other *Set[T]) bool {
+	if s.Len() > other.Len() {
+		return false
+	}
+	for item := range s.items {
+		if !other.Has(item) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsSuperset returns true if every element of the other Set is in this Set.
+func (s *Set[T]) IsSuperset(other *Set[T]) bool {
+	return other.IsSubset(s)
+}
+
+// Equal returns true if both Sets contain the same elements, regardless of the
+// order they were added in.
+func (s *Set[T]) Equal(other *Set[T]) bool {
+	return s.Len() == other.Len() && s.IsSubset(other)
+}
+
+// Values returns an iterator over the elements of the Set in no particular
+// order.
+func (s *Set[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for item := range s.items {
+			if !yield(item) {
+				return
+			}
+		}
+	}
+}
+
+// Slice returns the elements of the Set as a new slice in no particular order.
+func (s *Set[T]) Slice() []T {
+	res := make([]T, 0, len(s.items))
+	for item := range s.items {
+		res = append(res, item)
+	}
+	return res
+}
+
+// OrderedSlice returns the elements of the Set as a new slice in the order they
+// were first added to the Set.
+func (s *Set[T]) OrderedSlice() []T {
+	res := s.Slice()
+	sort.Slice(res, func(i, j int) bool {
+		return s.items[res[i]] < s.items[res[j]]
+	})
+	return res
+}
+
+// MarshalJSON encodes the Set as a JSON array of its elements in insertion
+// order. It has a value receiver so a Set held by value, for example as a
+// struct field, is encoded the same way as a *Set.
+func (s Set[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.OrderedSlice())
+}
+
+// UnmarshalJSON decodes a JSON array into the Set, replacing its elements.
+func (s *Set[T]) UnmarshalJSON(data []byte) error {
+	var items []T
+	if err := json.Unmarshal(data, &items); err != nil {
+		return err
+	}
+	*s = Set[T]{}
+	s.Add(items...)
+	return nil
+}
+
+// Union returns a new slice containing the elements that are in either slice
+// without duplicates, in the order they first occur in left and then right.
+func Union[T comparable](left, right []T) []T {
+	res := make([]T, 0, len(left)+len(right))
+	seen := make(map[T]struct{}, len(left)+len(right))
+	for _, s := range [][]T{left, right} {
+		for _, item := range s {
+			if _, ok := seen[item]; ok {
+				continue
+			}
+			seen[item] = struct{}{}
+			res = append(res, item)
+		}
+	}
+	return res
+}
+
+// Intersect returns a new slice containing the elements of left that are also
+// in right without duplicates, in the order they first occur in left.
+func Intersect[T comparable](left, right []T) []T {
+	return filterMembership(left, right, true)
+}
+
+// Difference returns a new slice containing the elements of left that aren't in
+// right without duplicates, in the order they first occur in left.
+func Difference[T comparable](left, right []T) []T {
+	return filterMembership(left, right, false)
+}
+
+// filterMembership returns the unique elements of left whose membership in
+// right matches member.
+func filterMembership[T comparable](left, right []T, member bool) []T {
+	in := make(map[T]struct{}, len(right))
+	for _, item := range right {
+		in[item] = struct{}{}
+	}
+	res := make([]T, 0)
+	seen := make(map[T]struct{}, len(left))
+	for _, item := range left {
+		if _, ok := seen[item]; ok {
+			continue
+		}
+		seen[item] = struct{}{}
+		if _, ok := in[item]; ok == member {
+			res = append(res, item)
+		}
+	}
+	return res
+}
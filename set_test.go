@@ -0,0 +1,133 @@
+package slices
+
+import (
+	"encoding/json"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSet(t *testing.T) {
+	var s Set[string]
+	assert.Equal(t, 0, s.Len())
+	assert.False(t, s.Has("a"))
+
+	s.Add("c", "a", "b", "a")
+	assert.Equal(t, 3, s.Len())
+	assert.True(t, s.Has("a"))
+	assert.Equal(t, []string{"c", "a", "b"}, s.OrderedSlice())
+
+	s.Remove("a", "z")
+	assert.False(t, s.Has("a"))
+	s.Add("a")
+	assert.Equal(t, []string{"c", "b", "a"}, s.OrderedSlice())
+
+	items := s.Slice()
+	sort.Strings(items)
+	assert.Equal(t, []string{"a", "b", "c"}, items)
+	assert.ElementsMatch(t, items, Collect(s.Values()))
+}
+
+func TestSet_Operations(t *testing.T) {
+	a := NewSet(1, 2, 3, 4)
+	b := NewSet(6, 4, 5, 3)
+
+	tests := []struct {
+		name     string
+		actual   *Set[int]
+		expected []int
+	}{
+		{
+			name:     "Union",
+			actual:   a.Union(b),
+			expected: []int{1, 2, 3, 4, 6, 5},
+		},
+		{
+			name:     "Intersection",
+			actual:   a.Intersection(b),
+			expected: []int{3, 4},
+		},
+		{
+			name:     "Difference",
+			actual:   a.Difference(b),
+			expected: []int{1, 2},
+		},
+		{
+			name:     "Symmetric Difference",
+			actual:   a.SymmetricDifference(b),
+			expected: []int{1, 2, 6, 5},
+		},
+		{
+			name:     "Empty Intersection",
+			actual:   a.Intersection(NewSet[int]()),
+			expected: []int{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, test.actual.OrderedSlice())
+		})
+	}
+}
+
+func TestSet_Subset(t *testing.T) {
+	a := NewSet(1, 2)
+	b := NewSet(2, 1, 3)
+
+	assert.True(t, a.IsSubset(b))
+	assert.False(t, b.IsSubset(a))
+	assert.True(t, b.IsSuperset(a))
+	assert.False(t, a.IsSuperset(b))
+	assert.True(t, NewSet[int]().IsSubset(a))
+	assert.True(t, a.Equal(NewSet(2, 1)))
+	assert.False(t, a.Equal(b))
+}
+
+func TestSet_JSON(t *testing.T) {
+	data, err := json.Marshal(NewSet("b", "a", "c"))
+	assert.NoError(t, err)
+	assert.JSONEq(t, `["b","a","c"]`, string(data))
+
+	s := NewSet("z")
+	assert.NoError(t, json.Unmarshal([]byte(`["x","y","x"]`), s))
+	assert.Equal(t, []string{"x", "y"}, s.OrderedSlice())
+
+	assert.Error(t, json.Unmarshal([]byte(`{"x":1}`), s))
+}
+
+func TestSet_JSONByValue(t *testing.T) {
+	type config struct {
+		Tags Set[string] `json:"tags"`
+	}
+
+	in := config{}
+	in.Tags.Add("b", "a", "c")
+	data, err := json.Marshal(in)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"tags":["b","a","c"]}`, string(data))
+
+	var out config
+	assert.NoError(t, json.Unmarshal(data, &out))
+	assert.Equal(t, []string{"b", "a", "c"}, out.Tags.OrderedSlice())
+
+	data, err = json.Marshal(config{})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"tags":[]}`, string(data))
+}
+
+func TestUnion(t *testing.T) {
+	assert.Equal(t, []int{3, 1, 2, 4}, Union([]int{3, 1, 3, 2}, []int{2, 4, 1}))
+	assert.Equal(t, []int{}, Union[int](nil, nil))
+}
+
+func TestIntersect(t *testing.T) {
+	assert.Equal(t, []int{3, 2}, Intersect([]int{3, 1, 3, 2}, []int{2, 4, 3}))
+	assert.Equal(t, []int{}, Intersect([]int{1}, nil))
+}
+
+func TestDifference(t *testing.T) {
+	assert.Equal(t, []int{1, 5}, Difference([]int{3, 1, 3, 5, 2, 1}, []int{2, 4, 3}))
+	assert.Equal(t, []int{1}, Difference([]int{1, 1}, nil))
+}
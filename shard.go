@@ -0,0 +1,102 @@
+package slices
+
+import (
+	"fmt"
+	"hash/fnv"
+)
+
+// SampleByKey returns a new slice containing the elements whose key, generated
+// by keyFn, is selected by hashing it together with the seed. Roughly the given
+// fraction of the distinct keys is selected, and since the selection only
+// depends on the key and the seed the same keys are always selected, across
+// calls and processes. All the elements that share a key are either included
+// or excluded together.
+//
+// Increasing the fraction only adds keys to the selection, so the keys selected
+// for 5% are also selected for 10%. Different seeds select independent sets of
+// keys, for example for running several experiments on the same users.
+//
+// A fraction of 0 or less selects no elements and a fraction of 1 or more
+// selects all of them.
+func SampleByKey[T any](in []T, keyFn func(item T) string, fraction float64, seed uint64) []T {
+	res := make([]T, 0)
+	if fraction <= 0 {
+		return res
+	}
+	for _, item := range in {
+		// The top 53 bits of the hash map it uniformly onto [0, 1)
+		point := float64(hashKey(keyFn(item), seed)>>11) / (1 << 53)
+		if point < fraction {
+			res = append(res, item)
+		}
+	}
+	return res
+}
+
+// ShardByKey splits a slice into n shards by hashing the key generated by keyFn
+// for each element together with the seed. The elements that share a key always
+// end up in the same shard, across calls and processes, and the order of the
+// elements within a shard matches the order of the input slice. Every shard is
+// returned even if it's empty.
+//
+// Different seeds assign keys to shards independently, for example for
+// separate canary rings. The assignment is also independent of the keys
+// selected by SampleByKey, even when both use the same seed.
+//
+// Shards are assigned with consistent hashing, so when the number of shards
+// grows from n to n+1 only about 1/(n+1) of the keys move, all of them into the
+// new shard.
+//
+// Providing an n less than 1 will result in a panic.
+func ShardByKey[T any](in []T, keyFn func(item T) string, n int, seed uint64) [][]T {
+	if n < 1 {
+		panic(fmt.Errorf("illegal number of shards, cannot create less than 1 shard"))
+	}
+	shards := make([][]T, n)
+	for i := range shards {
+		shards[i] = make([]T, 0)
+	}
+	for _, item := range in {
+		shard := jumpHash(hashKey(keyFn(item), seed^shardSalt), n)
+		shards[shard] = append(shards[shard], item)
+	}
+	return shards
+}
+
+// shardSalt is mixed into the seed used by ShardByKey so its hashes differ from
+// the ones SampleByKey computes for the same seed.
+const shardSalt = 0x9e3779b97f4a7c15
+
+// hashKey hashes a key together with a seed. The hash is stable, it doesn't
+// change between processes or platforms.
+func hashKey(key string, seed uint64) uint64 {
+	h := fnv.New64a()
+	var b [8]byte
+	for i := range b {
+		b[i] = byte(seed >> (8 * i))
+	}
+	_, _ = h.Write(b[:])
+	_, _ = h.Write([]byte(key))
+
+	// FNV doesn't spread similar keys evenly across all the bits, so the hash
+	// is finished with the splitmix64 finalizer.
+	x := h.Sum64()
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+	return x
+}
+
+// jumpHash maps a hash onto one of n buckets using the jump consistent hash
+// algorithm by Lamping and Veach.
+func jumpHash(key uint64, n int) int {
+	var b, j int64 = -1, 0
+	for j < int64(n) {
+		b = j
+		key = key*2862933555777941757 + 1
+		j = int64(float64(b+1) * (float64(int64(1)<<31) / float64((key>>33)+1)))
+	}
+	return int(b)
+}
@@ -0,0 +1,160 @@
+package slices
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func generateUserIDs(n int) []string {
+	return Map(generateDataSet(n), func(i int) string {
+		return "user-" + strconv.Itoa(i)
+	})
+}
+
+func userID(s string) string {
+	return s
+}
+
+func TestSampleByKey(t *testing.T) {
+	users := generateUserIDs(10000)
+
+	tests := []struct {
+		name     string
+		fraction float64
+		min, max int
+	}{
+		{
+			name:     "Five Percent",
+			fraction: 0.05,
+			min:      400,
+			max:      600,
+		},
+		{
+			name:     "Half",
+			fraction: 0.5,
+			min:      4800,
+			max:      5200,
+		},
+		{
+			name:     "None",
+			fraction: 0,
+			min:      0,
+			max:      0,
+		},
+		{
+			name:     "All",
+			fraction: 1,
+			min:      10000,
+			max:      10000,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual := SampleByKey(users, userID, test.fraction, 1)
+			assert.GreaterOrEqual(t, len(actual), test.min)
+			assert.LessOrEqual(t, len(actual), test.max)
+
+			// The same keys are selected every time
+			assert.Equal(t, actual, SampleByKey(users, userID, test.fraction, 1))
+		})
+	}
+}
+
+func TestSampleByKey_Properties(t *testing.T) {
+	users := generateUserIDs(10000)
+
+	// Growing the fraction keeps the keys already selected
+	small := SampleByKey(users, userID, 0.05, 7)
+	large := SampleByKey(users, userID, 0.10, 7)
+	assert.Subset(t, large, small)
+
+	// Different seeds select different keys
+	assert.NotEqual(t, small, SampleByKey(users, userID, 0.05, 8))
+
+	// Elements sharing a key are selected together
+	duplicated := Concat(users, users)
+	assert.Len(t, SampleByKey(duplicated, userID, 0.05, 7), len(small)*2)
+}
+
+func TestShardByKey(t *testing.T) {
+	users := generateUserIDs(10000)
+	shards := ShardByKey(users, userID, 4, 7)
+
+	assert.Len(t, shards, 4)
+	total := 0
+	for _, shard := range shards {
+		total += len(shard)
+		assert.InDelta(t, 2500, len(shard), 250)
+	}
+	assert.Equal(t, len(users), total)
+
+	assert.Equal(t, shards, ShardByKey(users, userID, 4, 7))
+	assert.Equal(t, [][]string{{}, {}}, ShardByKey([]string{}, userID, 2, 7))
+	assert.Panics(t, func() {
+		ShardByKey(users, userID, 0, 7)
+	})
+}
+
+func TestShardByKey_AddingShardMovesMinimalKeys(t *testing.T) {
+	users := generateUserIDs(10000)
+
+	shardOf := func(shards [][]string) map[string]int {
+		res := make(map[string]int)
+		for i, shard := range shards {
+			for _, user := range shard {
+				res[user] = i
+			}
+		}
+		return res
+	}
+	before := shardOf(ShardByKey(users, userID, 4, 7))
+	after := shardOf(ShardByKey(users, userID, 5, 7))
+
+	moved := 0
+	for _, user := range users {
+		if before[user] != after[user] {
+			moved++
+			// Keys only ever move to the new shard
+			assert.Equal(t, 4, after[user])
+		}
+	}
+	assert.InDelta(t, 2000, moved, 250)
+}
+
+func TestShardByKey_Seed(t *testing.T) {
+	users := generateUserIDs(10000)
+
+	shardOf := func(shards [][]string) map[string]int {
+		res := make(map[string]int)
+		for i, shard := range shards {
+			for _, user := range shard {
+				res[user] = i
+			}
+		}
+		return res
+	}
+	a := shardOf(ShardByKey(users, userID, 2, 1))
+	b := shardOf(ShardByKey(users, userID, 2, 2))
+
+	// Independent assignments agree on about half the keys with 2 shards
+	same := 0
+	for _, user := range users {
+		if a[user] == b[user] {
+			same++
+		}
+	}
+	assert.InDelta(t, 5000, same, 300)
+
+	// Sampling with the same seed doesn't favor any shard
+	sampled := SampleByKey(users, userID, 0.5, 1)
+	inFirst := 0
+	for _, user := range sampled {
+		if a[user] == 0 {
+			inFirst++
+		}
+	}
+	assert.InDelta(t, len(sampled)/2, inFirst, 300)
+}
@@ -1,16 +1,5 @@
 package slices
 
-import (
-	"fmt"
-	"math/rand"
-	"sync"
-	"time"
-)
-
-var (
-	random = rand.New(rand.NewSource(time.Now().UnixNano()))
-)
-
 // Predicate represents a predicate (boolean-value function) of one argument
 type Predicate[T any] func(t T) bool
 
@@ -95,17 +84,58 @@ func CountBy[T any](in []T, pred Predicate[T]) int {
 }
 
 // Remove will remove all instances of a given element from the slice and return
-// the count of items removed.
+// the count of items removed. The slice is modified in place in a single pass,
+// and the elements between the new length and the original length are zeroed
+// so they can be garbage collected.
 func Remove[T comparable](slice []T, item T) ([]T, int) {
-	removed := 0
+	return RemoveIf(slice, func(t T) bool {
+		return t == item
+	})
+}
+
+// RemoveIf removes all the elements that satisfy the Predicate from the slice in
+// place, returning the shortened slice and the count of items removed. The
+// order of the remaining elements is preserved and the elements between the
+// new length and the original length are zeroed.
+func RemoveIf[T any](slice []T, pred Predicate[T]) ([]T, int) {
+	n := 0
 	for i := 0; i < len(slice); i++ {
-		if slice[i] == item {
-			removed++
-			slice = append(slice[:i], slice[i+1:]...)
-			i-- // since slice[i] was removed that index must be reprocessed
+		if pred(slice[i]) {
+			continue
 		}
+		slice[n] = slice[i]
+		n++
 	}
-	return slice, removed
+	clear(slice[n:])
+	return slice[:n], len(slice) - n
+}
+
+// RetainIf removes all the elements that don't satisfy the Predicate from the
+// slice in place, returning the shortened slice and the count of items removed.
+// It's the inverse of RemoveIf.
+func RetainIf[T any](slice []T, pred Predicate[T]) ([]T, int) {
+	return RemoveIf(slice, func(t T) bool {
+		return !pred(t)
+	})
+}
+
+// RemoveAt removes the element at the given index from the slice in place,
+// returning the shortened slice and the count of items removed. The vacated
+// element at the end of the slice is zeroed. If the index is out of bounds this
+// will panic.
+func RemoveAt[T any](slice []T, idx int) ([]T, int) {
+	return RemoveRange(slice, idx, idx+1)
+}
+
+// RemoveRange removes the elements from index from up to but not including index
+// to from the slice in place, returning the shortened slice and the count of
+// items removed. The vacated elements at the end of the slice are zeroed. If
+// the range is out of bounds or from is greater than to this will panic.
+func RemoveRange[T any](slice []T, from, to int) ([]T, int) {
+	_ = slice[from:to] // bounds check
+	n := copy(slice[from:], slice[to:])
+	clear(slice[from+n:])
+	return slice[:from+n], to - from
 }
 
 // Map creates a new slice mapping the values that result from applying the
@@ -150,11 +180,18 @@ func Reverse[T any](s []T) {
 }
 
 // Shuffle accepts a slice and shuffles the elements of the slice randomly
-// in place.
+// in place. It's safe to call Shuffle concurrently on different slices.
 func Shuffle[T any](s []T) {
-	random.Shuffle(len(s), func(i, j int) {
+	ShuffleWith(defaultRandomizer, s)
+}
+
+// ShuffleWith accepts a Randomizer and a slice and shuffles the elements of the
+// slice randomly in place using the Randomizer as the source of randomness.
+func ShuffleWith[T any](src Randomizer, s []T) {
+	for i := len(s) - 1; i > 0; i-- {
+		j := src.Intn(i + 1)
 		s[i], s[j] = s[j], s[i]
-	})
+	}
 }
 
 // Chunk accepts a slice and a size splitting the slice into chunks with a max length
@@ -191,6 +228,31 @@ func Batch[T any](slice []T, batchSize int) [][]T {
 	return Chunk(slice, batchSize)
 }
 
+// ChunkWhile splits the slice into chunks of consecutive elements. The function
+// is called with each pair of adjacent elements and a new chunk is started
+// between them when it returns false, so each chunk is a run of elements for
+// which it held. Like Chunk the chunks are subslices of the provided slice.
+func ChunkWhile[T any](slice []T, fn func(prev, next T) bool) [][]T {
+	chunks := make([][]T, 0)
+	start := 0
+	for i := 1; i <= len(slice); i++ {
+		if i == len(slice) || !fn(slice[i-1], slice[i]) {
+			chunks = append(chunks, slice[start:i])
+			start = i
+		}
+	}
+	return chunks
+}
+
+// SplitWhen splits the slice into chunks of consecutive elements. The function
+// is called with each pair of adjacent elements and a new chunk is started
+// between them when it returns true. It's the inverse of ChunkWhile.
+func SplitWhen[T any](slice []T, fn func(prev, next T) bool) [][]T {
+	return ChunkWhile(slice, func(prev, next T) bool {
+		return !fn(prev, next)
+	})
+}
+
 // Equal compares two slices to determine if they are equal. Slices are considered
 // equals if their lengths are the same and each element is the same, IE order
 // matters.
@@ -273,6 +335,123 @@ func Unique[T comparable](in []T) []T {
 	return result
 }
 
+// UniqueInPlace removes duplicate elements from the slice in place, keeping only
+// the first occurrence of each element, and returns the shortened slice. Unlike
+// Unique it reuses the backing array of the slice rather than allocating a new
+// one. The elements between the new length and the original length are zeroed
+// so they can be garbage collected.
+func UniqueInPlace[T comparable](in []T) []T {
+	seen := make(map[T]struct{}, len(in))
+	n := 0
+	for i := 0; i < len(in); i++ {
+		item := in[i]
+		if _, ok := seen[item]; ok {
+			continue
+		}
+		seen[item] = struct{}{}
+		in[n] = item
+		n++
+	}
+	clear(in[n:])
+	return in[:n]
+}
+
+// UniqueBy returns a new slice that doesn't contain any elements with duplicate
+// keys, as generated by the key function. If several elements have the same key
+// only the first occurrence is kept. Unlike Unique the elements don't need to be
+// comparable.
+func UniqueBy[T any, K comparable](in []T, keyFn func(item T) K) []T {
+	return UniqueByMerge(in, keyFn, KeepFirst[T])
+}
+
+// UniqueByMerge returns a new slice that doesn't contain any elements with
+// duplicate keys, as generated by the key function. When an element has the
+// same key as an element already seen the two are combined by the merge
+// function, which receives the element kept so far and the new element. The
+// result of the merge is placed at the position of the first occurrence of the
+// key.
+//
+// KeepFirst and KeepLast can be used as the merge function to keep the first or
+// the last occurrence of each key.
+func UniqueByMerge[T any, K comparable](in []T, keyFn func(item T) K, merge func(existing, item T) T) []T {
+	result := make([]T, 0, len(in))
+	seen := make(map[K]int, len(in))
+
+	for i := 0; i < len(in); i++ {
+		item := in[i]
+		key := keyFn(item)
+		if idx, ok := seen[key]; ok {
+			result[idx] = merge(result[idx], item)
+			continue
+		}
+
+		seen[key] = len(result)
+		result = append(result, item)
+	}
+	return result
+}
+
+// KeepFirst is a merge function for UniqueByMerge that keeps the first
+// occurrence of each key.
+func KeepFirst[T any](existing, _ T) T {
+	return existing
+}
+
+// KeepLast is a merge function for UniqueByMerge that keeps the last occurrence
+// of each key.
+func KeepLast[T any](_, item T) T {
+	return item
+}
+
+// UniqueFunc returns a new slice that doesn't contain any duplicate elements as
+// determined by the equal function, only the first occurrence is kept. It works
+// with elements that aren't comparable and can't be reduced to a key, but
+// compares each element with every element kept so far, so prefer Unique or
+// UniqueBy for large slices.
+func UniqueFunc[T any](in []T, equal func(a, b T) bool) []T {
+	result := make([]T, 0, len(in))
+	for i := 0; i < len(in); i++ {
+		item := in[i]
+		duplicate := false
+		for j := 0; j < len(result); j++ {
+			if equal(result[j], item) {
+				duplicate = true
+				break
+			}
+		}
+		if !duplicate {
+			result = append(result, item)
+		}
+	}
+	return result
+}
+
+// Compact returns a new slice where each run of adjacent equal elements is
+// replaced by a single copy of the element. Unlike Unique, equal elements that
+// aren't adjacent are all kept.
+func Compact[T comparable](in []T) []T {
+	return CompactBy(in, func(item T) T {
+		return item
+	})
+}
+
+// CompactBy returns a new slice where each run of adjacent elements with the
+// same key, as generated by the key function, is replaced by the first element
+// of the run.
+func CompactBy[T any, K comparable](in []T, keyFn func(item T) K) []T {
+	result := make([]T, 0)
+	var prev K
+	for i := 0; i < len(in); i++ {
+		key := keyFn(in[i])
+		if i > 0 && key == prev {
+			continue
+		}
+		prev = key
+		result = append(result, in[i])
+	}
+	return result
+}
+
 // GroupBy iterates over a slice and groups the results by the key generated from
 // the grouper function.
 func GroupBy[T any, U comparable](in []T, grouper func(item T) U) map[U][]T {
@@ -384,39 +563,3 @@ func Concat[S ~[]E, E any](slices ...S) S {
 
 	return newSlice
 }
-
-// ForEachParallel iterates through a slice in parallel using the specified
-// amount of parallelism.
-func ForEachParallel[T any](slice []T, fn func(T), parallelism int) {
-
-	if parallelism < 1 {
-		panic(fmt.Errorf("parallelism less than 0 not permitted"))
-	}
-
-	wg := sync.WaitGroup{}
-	wg.Add(parallelism)
-
-	chanSize := parallelism * 4
-	if chanSize > len(slice) {
-		chanSize = len(slice)
-	}
-
-	queue := make(chan T, chanSize)
-	for i := 0; i < parallelism; i++ {
-		go func() {
-			defer wg.Done()
-			for v := range queue {
-				fn(v)
-			}
-		}()
-	}
-
-	go func() {
-		for j := 0; j < len(slice); j++ {
-			queue <- slice[j]
-		}
-		close(queue)
-	}()
-
-	wg.Wait()
-}
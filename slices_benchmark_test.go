@@ -1,6 +1,8 @@
 package slices
 
 import (
+	"fmt"
+	"sort"
 	"testing"
 )
 
@@ -71,3 +73,179 @@ func BenchmarkForEachParallel(b *testing.B) {
 		})
 	}
 }
+
+func BenchmarkForEachParallelWorkStealing(b *testing.B) {
+	b.ReportAllocs()
+
+	input := generateDataSet(10000000)
+
+	b.Run("Plain Loop", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			for _, v := range input {
+				benchmarkSimulator(v)
+			}
+		}
+	})
+	for _, parallelism := range []int{4, 8} {
+		b.Run(fmt.Sprintf("SharedQueue with Parallelism of %d", parallelism), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				ForEachParallel(input, benchmarkSimulator, parallelism, WithScheduler(SharedQueue))
+			}
+		})
+		b.Run(fmt.Sprintf("WorkStealing with Parallelism of %d", parallelism), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				ForEachParallel(input, benchmarkSimulator, parallelism, WithScheduler(WorkStealing))
+			}
+		})
+	}
+}
+
+func BenchmarkParallelSort(b *testing.B) {
+	b.ReportAllocs()
+
+	src := NewRandomizer(42)
+	input := make([]int64, 1000000)
+	for i := range input {
+		input[i] = int64(src.Intn(len(input)))
+	}
+	less := func(a, b int64) bool {
+		return a < b
+	}
+	data := make([]int64, len(input))
+
+	b.Run("sort.Slice", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			copy(data, input)
+			sort.Slice(data, func(i, j int) bool { return data[i] < data[j] })
+		}
+	})
+	b.Run("ParallelSort with Parallelism of 4", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			copy(data, input)
+			ParallelSort(data, less, 4)
+		}
+	})
+	b.Run("ParallelSort with Parallelism of 8", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			copy(data, input)
+			ParallelSort(data, less, 8)
+		}
+	})
+	b.Run("RadixSort", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			copy(data, input)
+			RadixSort(data)
+		}
+	})
+}
+
+func BenchmarkRadixSortFloats(b *testing.B) {
+	b.ReportAllocs()
+
+	src := NewRandomizer(42)
+	input := make([]float64, 1000000)
+	for i := range input {
+		input[i] = src.Float64()*2e6 - 1e6
+	}
+	data := make([]float64, len(input))
+
+	b.Run("sort.Float64s", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			copy(data, input)
+			sort.Float64s(data)
+		}
+	})
+	b.Run("RadixSortFloats", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			copy(data, input)
+			RadixSortFloats(data)
+		}
+	})
+}
+
+func BenchmarkMapParallel(b *testing.B) {
+	b.ReportAllocs()
+
+	input := generateDataSet(1000000)
+	mapper := func(i int) int {
+		return i * 2
+	}
+
+	b.Run("Map", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			Map(input, mapper)
+		}
+	})
+	b.Run("MapParallel with Parallelism of 4", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			MapParallel(input, mapper, 4)
+		}
+	})
+	b.Run("MapParallel with Parallelism of 8", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			MapParallel(input, mapper, 8)
+		}
+	})
+}
+
+func BenchmarkExecutor(b *testing.B) {
+	b.ReportAllocs()
+
+	tests := []struct {
+		name        string
+		input       []int
+		parallelism int
+	}{
+		{
+			name:        "16 Elements with Parallelism of 4",
+			input:       generateDataSet(16),
+			parallelism: 4,
+		},
+		{
+			name:        "128 Elements with Parallelism of 8",
+			input:       generateDataSet(128),
+			parallelism: 8,
+		},
+		{
+			name:        "1024 Elements with Parallelism of 8",
+			input:       generateDataSet(1024),
+			parallelism: 8,
+		},
+	}
+
+	for _, test := range tests {
+		b.Run(test.name+" Spawning Goroutines", func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				ForEachParallel(test.input, benchmarkSimulator, test.parallelism, WithExecutor(nil))
+			}
+		})
+		b.Run(test.name+" on Executor", func(b *testing.B) {
+			e := NewExecutor(test.parallelism)
+			defer e.Close()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				ForEachParallel(test.input, benchmarkSimulator, test.parallelism, WithExecutor(e))
+			}
+		})
+	}
+}
+
+func BenchmarkStream(b *testing.B) {
+	b.ReportAllocs()
+
+	input := generateDataSet(1000000)
+	square := func(i int) int {
+		return i * i
+	}
+
+	b.Run("Eager", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_ = Map(Filter(input, isEven), square)[:10]
+		}
+	})
+	b.Run("Stream", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_ = MapStream(NewStream(input).Filter(isEven), square).Limit(10).Collect()
+		}
+	})
+}
@@ -256,6 +256,100 @@ func TestContains(t *testing.T) {
 		}
 	}
 }
+func TestRemoveIf(t *testing.T) {
+	a, b, c := 1, 2, 3
+	in := []*int{&a, &b, &c, &b}
+	backing := in
+
+	actual, removed := RemoveIf(in, func(p *int) bool {
+		return *p == 2
+	})
+	assert.Equal(t, 2, removed)
+	assert.Equal(t, []*int{&a, &c}, actual)
+	assert.Equal(t, []*int{nil, nil}, backing[2:])
+
+	empty, removed := RemoveIf([]int{}, isEven)
+	assert.Equal(t, 0, removed)
+	assert.Equal(t, []int{}, empty)
+}
+
+func TestRetainIf(t *testing.T) {
+	actual, removed := RetainIf([]int{1, 2, 3, 4, 5, 6}, isEven)
+	assert.Equal(t, 3, removed)
+	assert.Equal(t, []int{2, 4, 6}, actual)
+}
+
+func TestRemoveAt(t *testing.T) {
+	in := []string{"a", "b", "c", "d"}
+	backing := in
+
+	actual, removed := RemoveAt(in, 1)
+	assert.Equal(t, 1, removed)
+	assert.Equal(t, []string{"a", "c", "d"}, actual)
+	assert.Equal(t, "", backing[3])
+
+	actual, _ = RemoveAt(actual, 2)
+	assert.Equal(t, []string{"a", "c"}, actual)
+
+	assert.Panics(t, func() {
+		RemoveAt([]string{"a"}, 1)
+	})
+}
+
+func TestRemoveRange(t *testing.T) {
+	tests := []struct {
+		name     string
+		in       []int
+		from, to int
+		expected []int
+		removed  int
+	}{
+		{
+			name:     "Middle",
+			in:       []int{1, 2, 3, 4, 5},
+			from:     1,
+			to:       3,
+			expected: []int{1, 4, 5},
+			removed:  2,
+		},
+		{
+			name:     "All",
+			in:       []int{1, 2, 3},
+			from:     0,
+			to:       3,
+			expected: []int{},
+			removed:  3,
+		},
+		{
+			name:     "Empty Range",
+			in:       []int{1, 2, 3},
+			from:     2,
+			to:       2,
+			expected: []int{1, 2, 3},
+			removed:  0,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			backing := test.in
+			actual, removed := RemoveRange(test.in, test.from, test.to)
+			assert.Equal(t, test.expected, actual)
+			assert.Equal(t, test.removed, removed)
+			for _, v := range backing[len(actual):] {
+				assert.Zero(t, v)
+			}
+		})
+	}
+
+	assert.Panics(t, func() {
+		RemoveRange([]int{1, 2, 3}, 2, 1)
+	})
+	assert.Panics(t, func() {
+		RemoveRange([]int{1, 2, 3}, 1, 4)
+	})
+}
+
 func TestMap(t *testing.T) {
 	tests := []struct {
 		name string
@@ -800,6 +894,177 @@ func TestUnique(t *testing.T) {
 	}
 }
 
+func TestUniqueInPlace(t *testing.T) {
+	in := []string{"pizza", "pineapple", "pizza", "hamburger", "salad", "pizza"}
+	backing := in
+
+	actual := UniqueInPlace(in)
+	assert.Equal(t, []string{"pizza", "pineapple", "hamburger", "salad"}, actual)
+	assert.Equal(t, &backing[0], &actual[0])
+	assert.Equal(t, []string{"", ""}, backing[4:])
+
+	assert.Equal(t, []string{}, UniqueInPlace([]string{}))
+}
+
+func TestUniqueBy(t *testing.T) {
+	type record struct {
+		ID      int
+		Version int
+		Tags    []string
+	}
+	byID := func(r record) int {
+		return r.ID
+	}
+	in := []record{
+		{ID: 1, Version: 1, Tags: []string{"a"}},
+		{ID: 2, Version: 1},
+		{ID: 1, Version: 2, Tags: []string{"b"}},
+		{ID: 3, Version: 1},
+		{ID: 2, Version: 3},
+	}
+
+	tests := []struct {
+		name     string
+		actual   func() []record
+		expected []record
+	}{
+		{
+			name: "First Wins",
+			actual: func() []record {
+				return UniqueBy(in, byID)
+			},
+			expected: []record{in[0], in[1], in[3]},
+		},
+		{
+			name: "Last Wins",
+			actual: func() []record {
+				return UniqueByMerge(in, byID, KeepLast[record])
+			},
+			expected: []record{in[2], in[4], in[3]},
+		},
+		{
+			name: "Merge",
+			actual: func() []record {
+				return UniqueByMerge(in, byID, func(existing, item record) record {
+					existing.Version = item.Version
+					existing.Tags = append(Clone(existing.Tags), item.Tags...)
+					return existing
+				})
+			},
+			expected: []record{
+				{ID: 1, Version: 2, Tags: []string{"a", "b"}},
+				{ID: 2, Version: 3},
+				{ID: 3, Version: 1},
+			},
+		},
+		{
+			name: "Empty",
+			actual: func() []record {
+				return UniqueBy(nil, byID)
+			},
+			expected: []record{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, test.actual())
+		})
+	}
+}
+
+func TestUniqueFunc(t *testing.T) {
+	in := [][]int{{1, 2}, {3}, {1, 2}, {}, {3}, nil}
+	actual := UniqueFunc(in, func(a, b []int) bool {
+		return Equal(a, b)
+	})
+	assert.Equal(t, [][]int{{1, 2}, {3}, {}}, actual)
+}
+
+func TestCompact(t *testing.T) {
+	tests := []struct {
+		name     string
+		in       []int
+		expected []int
+	}{
+		{
+			name:     "Adjacent Duplicates",
+			in:       []int{1, 1, 2, 3, 3, 3, 1, 1},
+			expected: []int{1, 2, 3, 1},
+		},
+		{
+			name:     "No Duplicates",
+			in:       []int{1, 2, 1},
+			expected: []int{1, 2, 1},
+		},
+		{
+			name:     "Empty",
+			in:       nil,
+			expected: []int{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, Compact(test.in))
+		})
+	}
+}
+
+func TestCompactBy(t *testing.T) {
+	type reading struct {
+		Sensor string
+		Value  int
+	}
+	in := []reading{{"a", 1}, {"a", 2}, {"b", 3}, {"a", 4}, {"a", 5}}
+	actual := CompactBy(in, func(r reading) string {
+		return r.Sensor
+	})
+	assert.Equal(t, []reading{{"a", 1}, {"b", 3}, {"a", 4}}, actual)
+}
+
+func TestChunkWhile(t *testing.T) {
+	consecutive := func(prev, next int) bool {
+		return next == prev+1
+	}
+
+	tests := []struct {
+		name     string
+		in       []int
+		expected [][]int
+	}{
+		{
+			name:     "Consecutive Runs",
+			in:       []int{1, 2, 4, 9, 10, 11, 12, 15},
+			expected: [][]int{{1, 2}, {4}, {9, 10, 11, 12}, {15}},
+		},
+		{
+			name:     "Single Element",
+			in:       []int{1},
+			expected: [][]int{{1}},
+		},
+		{
+			name:     "Empty",
+			in:       nil,
+			expected: [][]int{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, ChunkWhile(test.in, consecutive))
+		})
+	}
+}
+
+func TestSplitWhen(t *testing.T) {
+	decreasing := func(prev, next int) bool {
+		return next < prev
+	}
+	actual := SplitWhen([]int{1, 3, 5, 2, 4, 0, 6}, decreasing)
+	assert.Equal(t, [][]int{{1, 3, 5}, {2, 4}, {0, 6}}, actual)
+}
+
 func TestGroupBy(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -0,0 +1,148 @@
+package slices
+
+import (
+	"cmp"
+	stdslices "slices"
+	"sort"
+)
+
+// Comparator compares two values returning a negative number when a is less
+// than b, a positive number when a is greater than b and zero when they are
+// equal. Comparators can be combined to sort by several keys:
+//
+//	byName := ComparingBy(func(p Person) string { return p.LastName }).
+//		ThenBy(ComparingBy(func(p Person) string { return p.FirstName })).
+//		ThenByDescending(ComparingBy(func(p Person) int { return p.ID }))
+type Comparator[T any] func(a, b T) int
+
+// ComparingBy returns a Comparator that compares values by the key generated
+// from the key function.
+func ComparingBy[T any, K cmp.Ordered](keyFn func(item T) K) Comparator[T] {
+	return func(a, b T) int {
+		return cmp.Compare(keyFn(a), keyFn(b))
+	}
+}
+
+// Natural returns a Comparator that compares ordered values by their natural
+// order.
+func Natural[T cmp.Ordered]() Comparator[T] {
+	return cmp.Compare[T]
+}
+
+// ThenBy returns a Comparator that compares values using c, and when they are
+// equal breaks the tie using next.
+func (c Comparator[T]) ThenBy(next Comparator[T]) Comparator[T] {
+	return func(a, b T) int {
+		if res := c(a, b); res != 0 {
+			return res
+		}
+		return next(a, b)
+	}
+}
+
+// ThenByDescending returns a Comparator that compares values using c, and when
+// they are equal breaks the tie using next in reverse order.
+func (c Comparator[T]) ThenByDescending(next Comparator[T]) Comparator[T] {
+	return c.ThenBy(next.Reversed())
+}
+
+// Reversed returns a Comparator that imposes the reverse order of c.
+func (c Comparator[T]) Reversed() Comparator[T] {
+	return func(a, b T) int {
+		return c(b, a)
+	}
+}
+
+// NilsFirst returns a Comparator of pointers that orders nil before any other
+// value, and compares the values pointed to using c otherwise.
+func NilsFirst[T any](c Comparator[T]) Comparator[*T] {
+	return nilsComparator(c, -1)
+}
+
+// NilsLast returns a Comparator of pointers that orders nil after any other
+// value, and compares the values pointed to using c otherwise.
+func NilsLast[T any](c Comparator[T]) Comparator[*T] {
+	return nilsComparator(c, 1)
+}
+
+func nilsComparator[T any](c Comparator[T], nilOrder int) Comparator[*T] {
+	return func(a, b *T) int {
+		switch {
+		case a == nil && b == nil:
+			return 0
+		case a == nil:
+			return nilOrder
+		case b == nil:
+			return -nilOrder
+		}
+		return c(*a, *b)
+	}
+}
+
+// SortFunc sorts the slice in place in the order determined by the Comparator.
+// The sort isn't stable, use SortStableFunc to keep equal elements in their
+// original order.
+func SortFunc[T any](in []T, c Comparator[T]) {
+	stdslices.SortFunc(in, c)
+}
+
+// SortStableFunc sorts the slice in place in the order determined by the
+// Comparator, keeping equal elements in their original order.
+func SortStableFunc[T any](in []T, c Comparator[T]) {
+	stdslices.SortStableFunc(in, c)
+}
+
+// SortBy sorts the slice in place in ascending order of the key generated from
+// the key function. The key function is called once per element rather than
+// on every comparison. The sort isn't stable, use SortStableBy to keep elements
+// with equal keys in their original order.
+func SortBy[T any, K cmp.Ordered](in []T, keyFn func(item T) K) {
+	sort.Sort(newKeyedSlice(in, keyFn))
+}
+
+// SortStableBy sorts the slice in place in ascending order of the key generated
+// from the key function, keeping elements with equal keys in their original
+// order.
+func SortStableBy[T any, K cmp.Ordered](in []T, keyFn func(item T) K) {
+	sort.Stable(newKeyedSlice(in, keyFn))
+}
+
+// IsSortedBy returns true if the slice is sorted in ascending order of the key
+// generated from the key function.
+func IsSortedBy[T any, K cmp.Ordered](in []T, keyFn func(item T) K) bool {
+	return IsSortedFunc(in, ComparingBy(keyFn))
+}
+
+// IsSortedFunc returns true if the slice is sorted in the order determined by
+// the Comparator.
+func IsSortedFunc[T any](in []T, c Comparator[T]) bool {
+	return stdslices.IsSortedFunc(in, c)
+}
+
+// keyedSlice sorts a slice by keys computed up front, keeping the keys in step
+// with the elements as they are swapped.
+type keyedSlice[T any, K cmp.Ordered] struct {
+	items []T
+	keys  []K
+}
+
+func newKeyedSlice[T any, K cmp.Ordered](in []T, keyFn func(item T) K) keyedSlice[T, K] {
+	keys := make([]K, len(in))
+	for i := range in {
+		keys[i] = keyFn(in[i])
+	}
+	return keyedSlice[T, K]{items: in, keys: keys}
+}
+
+func (s keyedSlice[T, K]) Len() int {
+	return len(s.items)
+}
+
+func (s keyedSlice[T, K]) Less(i, j int) bool {
+	return cmp.Less(s.keys[i], s.keys[j])
+}
+
+func (s keyedSlice[T, K]) Swap(i, j int) {
+	s.items[i], s.items[j] = s.items[j], s.items[i]
+	s.keys[i], s.keys[j] = s.keys[j], s.keys[i]
+}
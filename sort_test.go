@@ -0,0 +1,107 @@
+package slices
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type person struct {
+	FirstName string
+	LastName  string
+	ID        int
+}
+
+func TestComparator(t *testing.T) {
+	people := []person{
+		{"Jane", "Smith", 1},
+		{"John", "Doe", 2},
+		{"Jane", "Doe", 3},
+		{"Jane", "Doe", 4},
+		{"Adam", "Smith", 5},
+	}
+	byLastName := ComparingBy(func(p person) string { return p.LastName })
+	byFirstName := ComparingBy(func(p person) string { return p.FirstName })
+	byID := ComparingBy(func(p person) int { return p.ID })
+
+	tests := []struct {
+		name       string
+		comparator Comparator[person]
+		expected   []int
+	}{
+		{
+			name:       "Single Key",
+			comparator: byID,
+			expected:   []int{1, 2, 3, 4, 5},
+		},
+		{
+			name:       "Reversed",
+			comparator: byID.Reversed(),
+			expected:   []int{5, 4, 3, 2, 1},
+		},
+		{
+			name:       "Multiple Keys",
+			comparator: byLastName.ThenBy(byFirstName).ThenByDescending(byID),
+			expected:   []int{4, 3, 2, 5, 1},
+		},
+		{
+			name:       "Reversed Multiple Keys",
+			comparator: byLastName.ThenBy(byFirstName).Reversed(),
+			expected:   []int{1, 5, 2, 3, 4},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			in := Clone(people)
+			SortStableFunc(in, test.comparator)
+			assert.Equal(t, test.expected, Map(in, func(p person) int { return p.ID }))
+			assert.True(t, IsSortedFunc(in, test.comparator))
+		})
+	}
+}
+
+func TestNilsFirstAndLast(t *testing.T) {
+	one, two := 1, 2
+	in := []*int{&two, nil, &one, nil}
+
+	SortFunc(in, NilsFirst(Natural[int]()))
+	assert.Equal(t, []*int{nil, nil, &one, &two}, in)
+
+	SortFunc(in, NilsLast(Natural[int]()))
+	assert.Equal(t, []*int{&one, &two, nil, nil}, in)
+
+	SortFunc(in, NilsLast(Natural[int]()).Reversed())
+	assert.Equal(t, []*int{nil, nil, &two, &one}, in)
+}
+
+func TestSortBy(t *testing.T) {
+	calls := 0
+	in := []string{"ccc", "a", "bb", "dddd", ""}
+	SortBy(in, func(s string) int {
+		calls++
+		return len(s)
+	})
+	assert.Equal(t, []string{"", "a", "bb", "ccc", "dddd"}, in)
+	assert.Equal(t, 5, calls)
+
+	floats := []float64{3, math.NaN(), 1, 2}
+	SortBy(floats, func(f float64) float64 { return f })
+	assert.True(t, math.IsNaN(floats[0]))
+	assert.Equal(t, []float64{1, 2, 3}, floats[1:])
+}
+
+func TestSortStableBy(t *testing.T) {
+	in := []person{
+		{"Jane", "Smith", 1},
+		{"John", "Doe", 2},
+		{"Adam", "Smith", 3},
+		{"Jane", "Doe", 4},
+	}
+	SortStableBy(in, func(p person) string { return p.LastName })
+	assert.Equal(t, []int{2, 4, 1, 3}, Map(in, func(p person) int { return p.ID }))
+	assert.True(t, IsSortedBy(in, func(p person) string { return p.LastName }))
+	assert.False(t, IsSortedBy(in, func(p person) int { return p.ID }))
+	assert.True(t, IsSortedBy([]person{}, func(p person) int { return p.ID }))
+}
@@ -0,0 +1,159 @@
+package slices
+
+// SortedSlice is a slice that keeps its elements ordered by a Comparator as
+// they are inserted and removed, offering O(log n) lookups and range queries.
+// A SortedSlice created with NewUniqueSortedSlice behaves like an ordered set
+// and doesn't hold more than one of any equal elements.
+//
+// The slices returned by Values and the range queries share the underlying
+// array of the SortedSlice. They must not be modified, and are only valid until
+// the SortedSlice is next modified.
+//
+// A SortedSlice is created with NewSortedSlice or NewUniqueSortedSlice, the zero
+// value isn't usable. A SortedSlice isn't safe for concurrent use.
+type SortedSlice[T any] struct {
+	items  []T
+	cmp    Comparator[T]
+	unique bool
+}
+
+// NewSortedSlice creates a SortedSlice ordered by the Comparator containing the
+// given elements. Equal elements are kept in the order they were inserted.
+func NewSortedSlice[T any](c Comparator[T], items ...T) *SortedSlice[T] {
+	s := &SortedSlice[T]{
+		items: Clone(items),
+		cmp:   c,
+	}
+	SortStableFunc(s.items, c)
+	return s
+}
+
+// NewUniqueSortedSlice creates a SortedSlice ordered by the Comparator that
+// doesn't hold more than one of any equal elements. If several of the given
+// elements are equal only the first is kept.
+func NewUniqueSortedSlice[T any](c Comparator[T], items ...T) *SortedSlice[T] {
+	s := NewSortedSlice(c, items...)
+	s.unique = true
+	n := 0
+	for i := range s.items {
+		if n > 0 && c(s.items[n-1], s.items[i]) == 0 {
+			continue
+		}
+		s.items[n] = s.items[i]
+		n++
+	}
+	clear(s.items[n:])
+	s.items = s.items[:n]
+	return s
+}
+
+// Insert inserts the element into its position in the order, after any equal
+// elements, and returns true. If the SortedSlice is unique and already holds an
+// equal element it's left unchanged and false is returned.
+func (s *SortedSlice[T]) Insert(item T) bool {
+	i := UpperBoundFunc(s.items, item, s.cmp)
+	if s.unique && i > 0 && s.cmp(s.items[i-1], item) == 0 {
+		return false
+	}
+	s.items = Insert(s.items, i, item)
+	return true
+}
+
+// Remove removes the first element equal to item and returns true, or returns
+// false if there is none.
+func (s *SortedSlice[T]) Remove(item T) bool {
+	i, ok := BinarySearchFunc(s.items, item, s.cmp)
+	if !ok {
+		return false
+	}
+	s.items, _ = RemoveAt(s.items, i)
+	return true
+}
+
+// Contains returns true if the SortedSlice holds an element equal to item.
+func (s *SortedSlice[T]) Contains(item T) bool {
+	_, ok := BinarySearchFunc(s.items, item, s.cmp)
+	return ok
+}
+
+// Index returns the index of the first element equal to item, or -1 if there is
+// none.
+func (s *SortedSlice[T]) Index(item T) int {
+	i, ok := BinarySearchFunc(s.items, item, s.cmp)
+	if !ok {
+		return -1
+	}
+	return i
+}
+
+// Rank returns the number of elements less than item, which is the index item
+// has or would have in the SortedSlice.
+func (s *SortedSlice[T]) Rank(item T) int {
+	return LowerBoundFunc(s.items, item, s.cmp)
+}
+
+// Len returns the number of elements in the SortedSlice.
+func (s *SortedSlice[T]) Len() int {
+	return len(s.items)
+}
+
+// At returns the element at the given index. If the index is out of bounds
+// this will panic.
+func (s *SortedSlice[T]) At(idx int) T {
+	return s.items[idx]
+}
+
+// Values returns the elements of the SortedSlice in order. The returned slice
+// must not be modified.
+func (s *SortedSlice[T]) Values() []T {
+	return s.view(0, len(s.items))
+}
+
+// Between returns the elements greater than or equal to from and less than or
+// equal to to in order. The returned slice must not be modified.
+func (s *SortedSlice[T]) Between(from, to T) []T {
+	lo := LowerBoundFunc(s.items, from, s.cmp)
+	hi := UpperBoundFunc(s.items, to, s.cmp)
+	if hi < lo {
+		hi = lo
+	}
+	return s.view(lo, hi)
+}
+
+// GreaterThan returns the elements greater than item in order. The returned
+// slice must not be modified.
+func (s *SortedSlice[T]) GreaterThan(item T) []T {
+	return s.view(UpperBoundFunc(s.items, item, s.cmp), len(s.items))
+}
+
+// LessThan returns the elements less than item in order. The returned slice
+// must not be modified.
+func (s *SortedSlice[T]) LessThan(item T) []T {
+	return s.view(0, LowerBoundFunc(s.items, item, s.cmp))
+}
+
+// Floor returns the greatest element less than or equal to item and a boolean
+// indicating if found.
+func (s *SortedSlice[T]) Floor(item T) (res T, ok bool) {
+	i := UpperBoundFunc(s.items, item, s.cmp)
+	if i == 0 {
+		return res, false
+	}
+	return s.items[i-1], true
+}
+
+// Ceiling returns the least element greater than or equal to item and a boolean
+// indicating if found.
+func (s *SortedSlice[T]) Ceiling(item T) (res T, ok bool) {
+	i := LowerBoundFunc(s.items, item, s.cmp)
+	if i == len(s.items) {
+		return res, false
+	}
+	return s.items[i], true
+}
+
+// view returns items[lo:hi] with its capacity limited to its length, so that
+// appending to it can't overwrite the elements of the SortedSlice.
+func (s *SortedSlice[T]) view(lo, hi int) []T {
+	return s.items[lo:hi:hi]
+}
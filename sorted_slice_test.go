@@ -0,0 +1,123 @@
+package slices
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSortedSlice(t *testing.T) {
+	s := NewSortedSlice(Natural[int](), 5, 1, 3, 3)
+	assert.Equal(t, []int{1, 3, 3, 5}, s.Values())
+
+	assert.True(t, s.Insert(4))
+	assert.True(t, s.Insert(3))
+	assert.True(t, s.Insert(0))
+	assert.Equal(t, []int{0, 1, 3, 3, 3, 4, 5}, s.Values())
+	assert.Equal(t, 7, s.Len())
+	assert.Equal(t, 4, s.At(5))
+
+	assert.True(t, s.Contains(3))
+	assert.False(t, s.Contains(2))
+	assert.Equal(t, 2, s.Index(3))
+	assert.Equal(t, -1, s.Index(2))
+	assert.Equal(t, 2, s.Rank(2))
+	assert.Equal(t, 2, s.Rank(3))
+	assert.Equal(t, 7, s.Rank(10))
+
+	assert.True(t, s.Remove(3))
+	assert.False(t, s.Remove(2))
+	assert.Equal(t, []int{0, 1, 3, 3, 4, 5}, s.Values())
+}
+
+func TestSortedSlice_Unique(t *testing.T) {
+	byLastName := ComparingBy(func(p person) string { return p.LastName })
+	s := NewUniqueSortedSlice(byLastName,
+		person{"Jane", "Smith", 1},
+		person{"John", "Doe", 2},
+		person{"Adam", "Smith", 3},
+	)
+	assert.Equal(t, []person{{"John", "Doe", 2}, {"Jane", "Smith", 1}}, s.Values())
+
+	assert.False(t, s.Insert(person{"Jim", "Doe", 4}))
+	assert.True(t, s.Insert(person{"Jim", "Brown", 5}))
+	assert.Equal(t, []int{5, 2, 1}, Map(s.Values(), func(p person) int { return p.ID }))
+
+	assert.True(t, s.Remove(person{LastName: "Doe"}))
+	assert.True(t, s.Insert(person{"Jim", "Doe", 4}))
+	assert.Equal(t, []int{5, 4, 1}, Map(s.Values(), func(p person) int { return p.ID }))
+}
+
+func TestSortedSlice_RangeQueries(t *testing.T) {
+	s := NewSortedSlice(Natural[int](), 10, 20, 20, 30, 40)
+
+	tests := []struct {
+		name     string
+		actual   []int
+		expected []int
+	}{
+		{
+			name:     "Between",
+			actual:   s.Between(15, 30),
+			expected: []int{20, 20, 30},
+		},
+		{
+			name:     "Between Inclusive",
+			actual:   s.Between(10, 40),
+			expected: []int{10, 20, 20, 30, 40},
+		},
+		{
+			name:     "Between Inverted",
+			actual:   s.Between(30, 15),
+			expected: []int{},
+		},
+		{
+			name:     "Greater Than",
+			actual:   s.GreaterThan(20),
+			expected: []int{30, 40},
+		},
+		{
+			name:     "Less Than",
+			actual:   s.LessThan(20),
+			expected: []int{10},
+		},
+		{
+			name:     "Less Than Min",
+			actual:   s.LessThan(10),
+			expected: []int{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, test.actual)
+		})
+	}
+
+	floor, ok := s.Floor(25)
+	assert.True(t, ok)
+	assert.Equal(t, 20, floor)
+	floor, ok = s.Floor(30)
+	assert.True(t, ok)
+	assert.Equal(t, 30, floor)
+	_, ok = s.Floor(5)
+	assert.False(t, ok)
+
+	ceiling, ok := s.Ceiling(25)
+	assert.True(t, ok)
+	assert.Equal(t, 30, ceiling)
+	ceiling, ok = s.Ceiling(10)
+	assert.True(t, ok)
+	assert.Equal(t, 10, ceiling)
+	_, ok = s.Ceiling(41)
+	assert.False(t, ok)
+}
+
+func TestSortedSlice_ValuesReadOnly(t *testing.T) {
+	s := NewSortedSlice(Natural[int](), 3, 1, 2)
+	low := s.LessThan(3)
+	_ = append(low, 100)
+	assert.Equal(t, []int{1, 2, 3}, s.Values())
+
+	assert.Equal(t, 2, CountBy(s.Values(), func(i int) bool { return i > 1 }))
+}
@@ -0,0 +1,225 @@
+// Package stats provides numeric aggregations and descriptive statistics over
+// slices, taking care of the overflow, precision and NaN pitfalls of reducing
+// numbers by hand.
+package stats
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/jkratz55/slices"
+)
+
+// ErrOverflow is returned when the result of an integer aggregation doesn't
+// fit in the type being aggregated.
+var ErrOverflow = errors.New("integer overflow")
+
+// Number is a constraint that permits any integer or floating-point type.
+type Number interface {
+	slices.Integer | slices.Float
+}
+
+// Sum returns the sum of the numbers in the slice, or zero if it's empty.
+//
+// Floating-point numbers are summed using Neumaier's variant of Kahan
+// summation, which keeps the rounding error from growing with the length of the
+// slice. If any number is NaN, or the slice holds both +Inf and -Inf, the sum
+// is NaN. Integers are summed exactly and wrap around on overflow like the +
+// operator does, use SumChecked to detect overflow.
+func Sum[T Number](in []T) T {
+	if isFloat[T]() {
+		return T(floatSum(in))
+	}
+	var sum T
+	for _, v := range in {
+		sum += v
+	}
+	return sum
+}
+
+// SumChecked returns the sum of the numbers in the slice the same way Sum does,
+// but returns ErrOverflow if summing integers overflows.
+func SumChecked[T Number](in []T) (T, error) {
+	if isFloat[T]() {
+		return Sum(in), nil
+	}
+	var sum T
+	for i, v := range in {
+		next := sum + v
+		if (v > 0 && next < sum) || (v < 0 && next > sum) {
+			return 0, fmt.Errorf("sum overflows at index %d: %w", i, ErrOverflow)
+		}
+		sum = next
+	}
+	return sum, nil
+}
+
+// SumBy returns the sum of the numbers generated by applying fn to each element
+// of the slice, see Sum.
+func SumBy[T any, N Number](in []T, fn func(item T) N) N {
+	return Sum(slices.Map(in, fn))
+}
+
+// Product returns the product of the numbers in the slice, or one if it's
+// empty. Integers wrap around on overflow like the * operator does.
+func Product[T Number](in []T) T {
+	product := T(1)
+	for _, v := range in {
+		product *= v
+	}
+	return product
+}
+
+// Mean returns the arithmetic mean of the numbers in the slice and a boolean
+// indicating if the slice wasn't empty. The mean is computed in float64 using
+// compensated summation, so integers can't overflow.
+func Mean[T Number](in []T) (float64, bool) {
+	if len(in) == 0 {
+		return 0, false
+	}
+	return floatSum(in) / float64(len(in)), true
+}
+
+// AvgBy returns the arithmetic mean of the numbers generated by applying fn to
+// each element of the slice and a boolean indicating if the slice wasn't
+// empty, see Mean.
+func AvgBy[T any, N Number](in []T, fn func(item T) N) (float64, bool) {
+	return Mean(slices.Map(in, fn))
+}
+
+// WeightedMean returns the mean of the values weighted by the weight at the
+// same index and a boolean indicating if the total weight isn't zero. If the
+// slices are not of equal lengths this function will panic.
+func WeightedMean[T, W Number](values []T, weights []W) (float64, bool) {
+	if len(values) != len(weights) {
+		panic("cannot compute weighted mean of slices of different lengths")
+	}
+	var sum, total neumaier
+	for i := range values {
+		w := float64(weights[i])
+		sum.add(float64(values[i]) * w)
+		total.add(w)
+	}
+	if total.result() == 0 {
+		return 0, false
+	}
+	return sum.result() / total.result(), true
+}
+
+// Variance returns the population variance of the numbers in the slice and a
+// boolean indicating if the slice wasn't empty. It's computed in a single pass
+// using Welford's algorithm, which is numerically stable.
+func Variance[T Number](in []T) (float64, bool) {
+	if len(in) == 0 {
+		return 0, false
+	}
+	return welford(in) / float64(len(in)), true
+}
+
+// SampleVariance returns the sample variance of the numbers in the slice, using
+// Bessel's correction, and a boolean indicating if the slice has at least two
+// elements.
+func SampleVariance[T Number](in []T) (float64, bool) {
+	if len(in) < 2 {
+		return 0, false
+	}
+	return welford(in) / float64(len(in)-1), true
+}
+
+// StdDev returns the population standard deviation of the numbers in the slice
+// and a boolean indicating if the slice wasn't empty.
+func StdDev[T Number](in []T) (float64, bool) {
+	v, ok := Variance(in)
+	return math.Sqrt(v), ok
+}
+
+// SampleStdDev returns the sample standard deviation of the numbers in the
+// slice and a boolean indicating if the slice has at least two elements.
+func SampleStdDev[T Number](in []T) (float64, bool) {
+	v, ok := SampleVariance(in)
+	return math.Sqrt(v), ok
+}
+
+// SumByGroup returns the sum of the numbers generated by applying fn to the
+// elements of each group, such as the groups returned by slices.GroupBy.
+func SumByGroup[K comparable, T any, N Number](groups map[K][]T, fn func(item T) N) map[K]N {
+	res := make(map[K]N, len(groups))
+	for k, group := range groups {
+		res[k] = SumBy(group, fn)
+	}
+	return res
+}
+
+// AvgByGroup returns the arithmetic mean of the numbers generated by applying
+// fn to the elements of each group, such as the groups returned by
+// slices.GroupBy. Empty groups are left out of the result.
+func AvgByGroup[K comparable, T any, N Number](groups map[K][]T, fn func(item T) N) map[K]float64 {
+	res := make(map[K]float64, len(groups))
+	for k, group := range groups {
+		if avg, ok := AvgBy(group, fn); ok {
+			res[k] = avg
+		}
+	}
+	return res
+}
+
+// isFloat returns true if T is a floating-point type.
+func isFloat[T Number]() bool {
+	half := T(1)
+	half /= 2
+	return half != 0
+}
+
+// floatSum returns the sum of the numbers as a float64 using compensated
+// summation.
+func floatSum[T Number](in []T) float64 {
+	var sum neumaier
+	for _, v := range in {
+		sum.add(float64(v))
+	}
+	return sum.result()
+}
+
+// welford returns the sum of the squared differences from the mean of the
+// numbers.
+func welford[T Number](in []T) float64 {
+	var mean, m2 float64
+	for i, v := range in {
+		x := float64(v)
+		delta := x - mean
+		mean += delta / float64(i+1)
+		m2 += delta * (x - mean)
+	}
+	return m2
+}
+
+// neumaier accumulates a sum using Neumaier's improved Kahan summation, which
+// tracks the low-order bits lost by each addition in a separate compensation
+// term.
+type neumaier struct {
+	sum, compensation float64
+}
+
+func (n *neumaier) add(x float64) {
+	t := n.sum + x
+	if math.IsInf(t, 0) || math.IsNaN(t) {
+		// The compensation is meaningless once the sum isn't finite, and
+		// computing it would turn an infinite sum into NaN.
+		n.sum = t
+		return
+	}
+	if math.Abs(n.sum) >= math.Abs(x) {
+		n.compensation += (n.sum - t) + x
+	} else {
+		n.compensation += (x - t) + n.sum
+	}
+	n.sum = t
+}
+
+func (n *neumaier) result() float64 {
+	if math.IsInf(n.sum, 0) || math.IsNaN(n.sum) {
+		return n.sum
+	}
+	return n.sum + n.compensation
+}
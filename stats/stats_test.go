@@ -0,0 +1,166 @@
+package stats
+
+import (
+	"math"
+	"testing"
+
+	"github.com/jkratz55/slices"
+	"github.com/stretchr/testify/assert"
+)
+
+type order struct {
+	Customer string
+	Amount   float64
+	Items    int
+}
+
+func TestSum(t *testing.T) {
+	tests := []struct {
+		name     string
+		in       []float64
+		expected float64
+	}{
+		{
+			name:     "Simple",
+			in:       []float64{1, 2, 3.5},
+			expected: 6.5,
+		},
+		{
+			name:     "Compensated",
+			in:       []float64{1, 1e100, 1, -1e100},
+			expected: 2,
+		},
+		{
+			name:     "Empty",
+			in:       nil,
+			expected: 0,
+		},
+		{
+			name:     "Infinity",
+			in:       []float64{1, math.Inf(1), 2},
+			expected: math.Inf(1),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, Sum(test.in))
+		})
+	}
+
+	assert.True(t, math.IsNaN(Sum([]float64{1, math.NaN(), 2})))
+	assert.True(t, math.IsNaN(Sum([]float64{math.Inf(1), math.Inf(-1)})))
+
+	tenths := make([]float64, 1000000)
+	for i := range tenths {
+		tenths[i] = 0.1
+	}
+	assert.Equal(t, 100000.0, Sum(tenths))
+
+	assert.Equal(t, 10, Sum([]int{1, 2, 3, 4}))
+	assert.Equal(t, float32(1.5), Sum([]float32{0.5, 1}))
+	assert.Equal(t, int8(-128), Sum([]int8{127, 1}))
+}
+
+func TestSumChecked(t *testing.T) {
+	sum, err := SumChecked([]int8{100, 27, -50})
+	assert.NoError(t, err)
+	assert.Equal(t, int8(77), sum)
+
+	_, err = SumChecked([]int8{100, 27, 1})
+	assert.ErrorIs(t, err, ErrOverflow)
+
+	_, err = SumChecked([]int64{math.MinInt64, -1})
+	assert.ErrorIs(t, err, ErrOverflow)
+
+	_, err = SumChecked([]uint8{200, 56})
+	assert.ErrorIs(t, err, ErrOverflow)
+
+	fsum, err := SumChecked([]float64{1e308, 1e308})
+	assert.NoError(t, err)
+	assert.Equal(t, math.Inf(1), fsum)
+}
+
+func TestProduct(t *testing.T) {
+	assert.Equal(t, 24, Product([]int{1, 2, 3, 4}))
+	assert.Equal(t, 1, Product([]int{}))
+	assert.Equal(t, 0.25, Product([]float64{0.5, 0.5}))
+}
+
+func TestMean(t *testing.T) {
+	mean, ok := Mean([]int{1, 2, 3, 4})
+	assert.True(t, ok)
+	assert.Equal(t, 2.5, mean)
+
+	mean, ok = Mean([]int64{math.MaxInt64, math.MaxInt64})
+	assert.True(t, ok)
+	assert.Equal(t, float64(math.MaxInt64), mean)
+
+	_, ok = Mean([]float64{})
+	assert.False(t, ok)
+}
+
+func TestVariance(t *testing.T) {
+	in := []float64{2, 4, 4, 4, 5, 5, 7, 9}
+
+	variance, ok := Variance(in)
+	assert.True(t, ok)
+	assert.Equal(t, 4.0, variance)
+
+	stdDev, ok := StdDev(in)
+	assert.True(t, ok)
+	assert.Equal(t, 2.0, stdDev)
+
+	variance, ok = SampleVariance(in)
+	assert.True(t, ok)
+	assert.InDelta(t, 32.0/7, variance, 1e-12)
+
+	stdDev, ok = SampleStdDev(in)
+	assert.True(t, ok)
+	assert.InDelta(t, math.Sqrt(32.0/7), stdDev, 1e-12)
+
+	shifted := slices.Map(in, func(f float64) float64 { return f + 1e9 })
+	variance, _ = Variance(shifted)
+	assert.InDelta(t, 4.0, variance, 1e-6)
+
+	_, ok = Variance([]int{})
+	assert.False(t, ok)
+	_, ok = SampleVariance([]int{1})
+	assert.False(t, ok)
+}
+
+func TestWeightedMean(t *testing.T) {
+	mean, ok := WeightedMean([]float64{1, 2, 3}, []int{3, 0, 1})
+	assert.True(t, ok)
+	assert.Equal(t, 1.5, mean)
+
+	_, ok = WeightedMean([]float64{1, 2}, []float64{0, 0})
+	assert.False(t, ok)
+
+	assert.Panics(t, func() {
+		WeightedMean([]int{1}, []int{1, 2})
+	})
+}
+
+func TestGroupAggregations(t *testing.T) {
+	orders := []order{
+		{"alice", 10.5, 1},
+		{"bob", 3, 2},
+		{"alice", 4.5, 3},
+		{"carol", 7, 4},
+	}
+	byCustomer := slices.GroupBy(orders, func(o order) string {
+		return o.Customer
+	})
+
+	assert.Equal(t, 10, SumBy(orders, func(o order) int { return o.Items }))
+
+	avg, ok := AvgBy(orders, func(o order) float64 { return o.Amount })
+	assert.True(t, ok)
+	assert.Equal(t, 6.25, avg)
+
+	assert.Equal(t, map[string]float64{"alice": 15, "bob": 3, "carol": 7},
+		SumByGroup(byCustomer, func(o order) float64 { return o.Amount }))
+	assert.Equal(t, map[string]float64{"alice": 2, "bob": 2, "carol": 4},
+		AvgByGroup(byCustomer, func(o order) int { return o.Items }))
+}
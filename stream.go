@@ -0,0 +1,124 @@
+package slices
+
+import "iter"
+
+// Stream is a lazy sequence of elements. Operations such as Filter and Limit,
+// and functions such as MapStream, return a new Stream without processing any
+// elements. Elements are only passed through the chain of operations one at a
+// time once a terminal operation such as Collect or FindFirst is called, which
+// stops as soon as it has its result. This avoids allocating a slice for every
+// step of a chain like Filter, Map and Take.
+//
+// A Stream is built on an iter.Seq, see Seq and StreamFromSeq, so it can be
+// ranged over with a for loop, and breaking out of the loop stops the chain.
+//
+// A Stream is created with NewStream or StreamFromSeq, the zero value isn't
+// usable. Every terminal operation processes the underlying sequence again, a
+// Stream created with NewStream can be consumed any number of times while one
+// created from a single use iter.Seq can only be consumed once. A Stream isn't
+// safe for concurrent use.
+type Stream[T any] struct {
+	seq iter.Seq[T]
+}
+
+// NewStream creates a Stream over the elements of the slice. Changes made to
+// the slice before the Stream is consumed are visible to the Stream.
+func NewStream[T any](s []T) Stream[T] {
+	return Stream[T]{seq: Values(s)}
+}
+
+// StreamFromSeq creates a Stream over the elements of the iter.Seq.
+func StreamFromSeq[T any](seq iter.Seq[T]) Stream[T] {
+	return Stream[T]{seq: seq}
+}
+
+// Seq returns the Stream as an iter.Seq, which can be used with a for range
+// loop.
+func (s Stream[T]) Seq() iter.Seq[T] {
+	return s.seq
+}
+
+// Filter returns a Stream of the elements that satisfy the Predicate.
+func (s Stream[T]) Filter(pred Predicate[T]) Stream[T] {
+	return Stream[T]{seq: FilterSeq(s.seq, pred)}
+}
+
+// TakeWhile returns a Stream of the elements up to, but not including, the first
+// element that doesn't satisfy the Predicate.
+func (s Stream[T]) TakeWhile(pred Predicate[T]) Stream[T] {
+	return Stream[T]{seq: TakeWhileSeq(s.seq, pred)}
+}
+
+// DropWhile returns a Stream that skips elements as long as they satisfy the
+// Predicate, and then returns all the remaining elements.
+func (s Stream[T]) DropWhile(pred Predicate[T]) Stream[T] {
+	return Stream[T]{seq: DropWhileSeq(s.seq, pred)}
+}
+
+// Limit returns a Stream of at most the first n elements. Once n elements have
+// been returned no more elements are processed.
+func (s Stream[T]) Limit(n int) Stream[T] {
+	return Stream[T]{seq: LimitSeq(s.seq, n)}
+}
+
+// Skip returns a Stream that skips the first n elements.
+func (s Stream[T]) Skip(n int) Stream[T] {
+	return Stream[T]{seq: SkipSeq(s.seq, n)}
+}
+
+// Collect consumes the Stream and returns a new slice containing all its
+// elements.
+func (s Stream[T]) Collect() []T {
+	return Collect(s.seq)
+}
+
+// FindFirst consumes the Stream until it finds the first element that satisfies
+// the Predicate, returning it and a boolean indicating if found.
+func (s Stream[T]) FindFirst(pred Predicate[T]) (res T, found bool) {
+	for item := range s.seq {
+		if pred(item) {
+			return item, true
+		}
+	}
+	return res, false
+}
+
+// Count consumes the Stream and returns the number of elements.
+func (s Stream[T]) Count() int {
+	count := 0
+	for range s.seq {
+		count++
+	}
+	return count
+}
+
+// ForEach consumes the Stream passing each element to fn.
+func (s Stream[T]) ForEach(fn func(T)) {
+	for item := range s.seq {
+		fn(item)
+	}
+}
+
+// MapStream returns a Stream of the values that result from applying the map
+// function to the elements of the Stream.
+func MapStream[T, R any](s Stream[T], mapper func(item T) R) Stream[R] {
+	return Stream[R]{seq: MapSeq(s.seq, mapper)}
+}
+
+// FlatMapStream returns a Stream of the values in the slices that result from
+// applying the map function to the elements of the Stream.
+func FlatMapStream[T, R any](s Stream[T], mapper func(item T) []R) Stream[R] {
+	return Stream[R]{seq: FlatMapSeq(s.seq, mapper)}
+}
+
+// DistinctStream returns a Stream without duplicate elements, only the first
+// occurrence of each element is kept.
+func DistinctStream[T comparable](s Stream[T]) Stream[T] {
+	return Stream[T]{seq: UniqueSeq(s.seq)}
+}
+
+// ReduceStream consumes the Stream reducing it to a value that is accumulated
+// by iterating over each element.
+func ReduceStream[T, R any](s Stream[T], accum Accumulator[T, R], val R) R {
+	return ReduceSeq(s.seq, accum, val)
+}
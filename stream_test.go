@@ -0,0 +1,164 @@
+package slices
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func isEven(i int) bool {
+	return i%2 == 0
+}
+
+func TestStream(t *testing.T) {
+	tests := []struct {
+		name     string
+		stream   func() Stream[int]
+		expected []int
+	}{
+		{
+			name: "Collect",
+			stream: func() Stream[int] {
+				return NewStream([]int{1, 2, 3})
+			},
+			expected: []int{1, 2, 3},
+		},
+		{
+			name: "Nil Slice",
+			stream: func() Stream[int] {
+				return NewStream[int](nil)
+			},
+			expected: []int{},
+		},
+		{
+			name: "Filter",
+			stream: func() Stream[int] {
+				return NewStream(generateDataSet(10)).Filter(isEven)
+			},
+			expected: []int{0, 2, 4, 6, 8},
+		},
+		{
+			name: "TakeWhile",
+			stream: func() Stream[int] {
+				return NewStream([]int{2, 4, 5, 6}).TakeWhile(isEven)
+			},
+			expected: []int{2, 4},
+		},
+		{
+			name: "DropWhile",
+			stream: func() Stream[int] {
+				return NewStream([]int{2, 4, 5, 6}).DropWhile(isEven)
+			},
+			expected: []int{5, 6},
+		},
+		{
+			name: "Limit",
+			stream: func() Stream[int] {
+				return NewStream(generateDataSet(10)).Limit(3)
+			},
+			expected: []int{0, 1, 2},
+		},
+		{
+			name: "Skip",
+			stream: func() Stream[int] {
+				return NewStream(generateDataSet(5)).Skip(3)
+			},
+			expected: []int{3, 4},
+		},
+		{
+			name: "Skip More Than Length",
+			stream: func() Stream[int] {
+				return NewStream(generateDataSet(5)).Skip(10)
+			},
+			expected: []int{},
+		},
+		{
+			name: "Map",
+			stream: func() Stream[int] {
+				return MapStream(NewStream([]int{1, 2, 3}), func(i int) int {
+					return i * 10
+				})
+			},
+			expected: []int{10, 20, 30},
+		},
+		{
+			name: "FlatMap",
+			stream: func() Stream[int] {
+				return FlatMapStream(NewStream([]int{1, 0, 2, 3}), func(i int) []int {
+					return generateDataSet(i)
+				})
+			},
+			expected: []int{0, 0, 1, 0, 1, 2},
+		},
+		{
+			name: "Distinct",
+			stream: func() Stream[int] {
+				return DistinctStream(NewStream([]int{1, 2, 1, 3, 2}))
+			},
+			expected: []int{1, 2, 3},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, test.stream().Collect())
+		})
+	}
+}
+
+func TestStream_ShortCircuits(t *testing.T) {
+	mapped := 0
+	s := MapStream(NewStream(generateDataSet(1000000)).Filter(isEven), func(i int) string {
+		mapped++
+		return strconv.Itoa(i)
+	}).Limit(3)
+
+	assert.Equal(t, 0, mapped)
+	assert.Equal(t, []string{"0", "2", "4"}, s.Collect())
+	assert.Equal(t, 3, mapped)
+
+	mapped = 0
+	first, ok := MapStream(NewStream(generateDataSet(100)), func(i int) int {
+		mapped++
+		return i * i
+	}).FindFirst(func(i int) bool {
+		return i > 10
+	})
+	assert.True(t, ok)
+	assert.Equal(t, 16, first)
+	assert.Equal(t, 5, mapped)
+}
+
+func TestStream_Terminals(t *testing.T) {
+	assert.Equal(t, 5, NewStream(generateDataSet(10)).Filter(isEven).Count())
+
+	sum := ReduceStream(NewStream(generateDataSet(5)), func(agg, i int) int {
+		return agg + i
+	}, 0)
+	assert.Equal(t, 10, sum)
+
+	var items []int
+	NewStream([]int{3, 2, 1}).ForEach(func(i int) {
+		items = append(items, i)
+	})
+	assert.Equal(t, []int{3, 2, 1}, items)
+
+	_, ok := NewStream([]int{1, 3}).FindFirst(isEven)
+	assert.False(t, ok)
+}
+
+func TestStream_Range(t *testing.T) {
+	var items []int
+	for i := range NewStream(generateDataSet(100)).Filter(isEven).Seq() {
+		if i > 6 {
+			break
+		}
+		items = append(items, i)
+	}
+	assert.Equal(t, []int{0, 2, 4, 6}, items)
+
+	s := StreamFromSeq(Values([]int{1, 2, 2, 3, 1}))
+	assert.Equal(t, []int{1, 2, 3}, DistinctStream(s).Collect())
+	assert.Equal(t, []int{1, 2, 3}, DistinctStream(s).Collect())
+}